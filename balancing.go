@@ -0,0 +1,104 @@
+package statisticofootballdata
+
+import (
+	"encoding/json"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/balancer/leastrequest"
+	"google.golang.org/grpc/balancer/roundrobin"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+// Load balancing policies for use with WithLoadBalancing. LeastRequest sends each call to the backend
+// with the fewest outstanding calls, spreading long lived streams more evenly than RoundRobin.
+const (
+	LeastRequest = leastrequest.Name
+	RoundRobin   = roundrobin.Name
+)
+
+const staticScheme = "statistico-static"
+
+// WithLoadBalancing sets the policy balancing calls across the addresses the target resolves to, such
+// as a dns:/// target or one using WithStaticAddresses. Calls are otherwise sent to a single address.
+func WithLoadBalancing(policy string) DialOption {
+	return func(o *dialOptions) {
+		o.loadBalancing = policy
+	}
+}
+
+// WithServiceConfig sets the gRPC service config JSON used unless the resolver provides one, for
+// configuring load balancing, retries and timeouts per method. A load balancing policy in the config
+// takes precedence over one set using WithLoadBalancing, which is otherwise added to the config.
+func WithServiceConfig(config string) DialOption {
+	return func(o *dialOptions) {
+		o.serviceConfig = config
+	}
+}
+
+// defaultServiceConfig returns the service config set using WithServiceConfig, with the policy set using
+// WithLoadBalancing added unless the config has its own.
+func (o dialOptions) defaultServiceConfig() string {
+	if o.loadBalancing == "" {
+		return o.serviceConfig
+	}
+
+	lb := json.RawMessage(fmt.Sprintf(`[{%q: {}}]`, o.loadBalancing))
+
+	if o.serviceConfig == "" {
+		return fmt.Sprintf(`{"loadBalancingConfig": %s}`, lb)
+	}
+
+	var cfg map[string]json.RawMessage
+
+	if err := json.Unmarshal([]byte(o.serviceConfig), &cfg); err != nil {
+		// Invalid configs are reported when the connection is created
+		return o.serviceConfig
+	}
+
+	if _, ok := cfg["loadBalancingConfig"]; ok {
+		return o.serviceConfig
+	}
+
+	if _, ok := cfg["loadBalancingPolicy"]; ok {
+		return o.serviceConfig
+	}
+
+	cfg["loadBalancingConfig"] = lb
+
+	b, err := json.Marshal(cfg)
+
+	if err != nil {
+		return o.serviceConfig
+	}
+
+	return string(b)
+}
+
+// WithStaticAddresses makes Dial balance calls across addrs rather than resolving its target, which
+// is then used only as the name of the service.
+func WithStaticAddresses(addrs ...string) DialOption {
+	return func(o *dialOptions) {
+		o.static = addrs
+	}
+}
+
+// resolve returns the target and options used to create a connection to target.
+func (o dialOptions) resolve(target string) (string, []grpc.DialOption) {
+	opts := o.grpcDialOptions()
+
+	if len(o.static) == 0 {
+		return target, opts
+	}
+
+	addrs := make([]resolver.Address, len(o.static))
+
+	for i, addr := range o.static {
+		addrs[i] = resolver.Address{Addr: addr}
+	}
+
+	r := manual.NewBuilderWithScheme(staticScheme)
+	r.InitialState(resolver.State{Addresses: addrs})
+
+	return staticScheme + ":///" + target, append(opts, grpc.WithResolvers(r))
+}
@@ -0,0 +1,192 @@
+package statisticofootballdata_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWithLoadBalancing(t *testing.T) {
+	t.Run("balances calls across static addresses using round robin", func(t *testing.T) {
+		t.Helper()
+
+		servers := []*teamServer{startTeamServer(t, "a"), startTeamServer(t, "b"), startTeamServer(t, "c")}
+
+		for _, s := range servers {
+			defer s.stop()
+		}
+
+		client, err := statisticofootballdata.Dial(
+			"data-service",
+			statisticofootballdata.WithStaticAddresses(servers[0].addr, servers[1].addr, servers[2].addr),
+			statisticofootballdata.WithLoadBalancing(statisticofootballdata.RoundRobin),
+		)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		assert.Equal(t, 3, len(servedBy(t, client, 60)))
+	})
+
+	t.Run("balances calls across static addresses using least request", func(t *testing.T) {
+		t.Helper()
+
+		servers := []*teamServer{startTeamServer(t, "a"), startTeamServer(t, "b")}
+
+		for _, s := range servers {
+			defer s.stop()
+		}
+
+		client, err := statisticofootballdata.Dial(
+			"data-service",
+			statisticofootballdata.WithStaticAddresses(servers[0].addr, servers[1].addr),
+			statisticofootballdata.WithLoadBalancing(statisticofootballdata.LeastRequest),
+		)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		served := servedBy(t, client, 40)
+
+		assert.Equal(t, 2, len(served))
+		assert.Equal(t, 40, served["a"]+served["b"])
+	})
+
+	t.Run("sends calls to a single address without load balancing", func(t *testing.T) {
+		t.Helper()
+
+		servers := []*teamServer{startTeamServer(t, "a"), startTeamServer(t, "b")}
+
+		for _, s := range servers {
+			defer s.stop()
+		}
+
+		client, err := statisticofootballdata.Dial(
+			"data-service",
+			statisticofootballdata.WithStaticAddresses(servers[0].addr, servers[1].addr),
+		)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		assert.Equal(t, 1, len(servedBy(t, client, 20)))
+	})
+}
+
+func TestWithServiceConfig(t *testing.T) {
+	t.Run("applies service config to the connection", func(t *testing.T) {
+		t.Helper()
+
+		servers := []*teamServer{startTeamServer(t, "a"), startTeamServer(t, "b")}
+
+		for _, s := range servers {
+			defer s.stop()
+		}
+
+		client, err := statisticofootballdata.Dial(
+			"data-service",
+			statisticofootballdata.WithStaticAddresses(servers[0].addr, servers[1].addr),
+			statisticofootballdata.WithServiceConfig(`{"loadBalancingConfig": [{"round_robin": {}}]}`),
+		)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		assert.Equal(t, 2, len(servedBy(t, client, 40)))
+	})
+
+	t.Run("prefers the load balancing policy of the service config whatever the option order", func(t *testing.T) {
+		t.Helper()
+
+		servers := []*teamServer{startTeamServer(t, "a"), startTeamServer(t, "b")}
+
+		for _, s := range servers {
+			defer s.stop()
+		}
+
+		config := statisticofootballdata.WithServiceConfig(`{"loadBalancingConfig": [{"round_robin": {}}]}`)
+		balancing := statisticofootballdata.WithLoadBalancing("pick_first")
+
+		for _, opts := range [][]statisticofootballdata.DialOption{{config, balancing}, {balancing, config}} {
+			client, err := statisticofootballdata.Dial(
+				"data-service",
+				append(opts, statisticofootballdata.WithStaticAddresses(servers[0].addr, servers[1].addr))...,
+			)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, 2, len(servedBy(t, client, 40)))
+
+			client.Close()
+		}
+	})
+
+	t.Run("adds the load balancing policy to a service config without one whatever the option order", func(t *testing.T) {
+		t.Helper()
+
+		servers := []*teamServer{startTeamServer(t, "a"), startTeamServer(t, "b")}
+
+		for _, s := range servers {
+			defer s.stop()
+		}
+
+		config := statisticofootballdata.WithServiceConfig(`{"methodConfig": [{"name": [{"service": "statistico.TeamService"}], "timeout": "5s"}]}`)
+		balancing := statisticofootballdata.WithLoadBalancing(statisticofootballdata.RoundRobin)
+
+		for _, opts := range [][]statisticofootballdata.DialOption{{config, balancing}, {balancing, config}} {
+			client, err := statisticofootballdata.Dial(
+				"data-service",
+				append(opts, statisticofootballdata.WithStaticAddresses(servers[0].addr, servers[1].addr))...,
+			)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, 2, len(servedBy(t, client, 40)))
+
+			client.Close()
+		}
+	})
+
+	t.Run("returns error for invalid service config", func(t *testing.T) {
+		t.Helper()
+
+		_, err := statisticofootballdata.Dial("localhost:50051", statisticofootballdata.WithServiceConfig(`{"loadBalancingConfig": 1}`))
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+	})
+}
+
+func servedBy(t *testing.T, client *statisticofootballdata.Client, calls int) map[string]int {
+	served := map[string]int{}
+
+	for i := 0; i < calls; i++ {
+		teams, err := client.Teams.BySeasonID(context.Background(), 16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		served[teams[0].GetName()]++
+	}
+
+	return served
+}
@@ -0,0 +1,437 @@
+package statisticofootballdata
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	defaultCacheSize   = 1000
+	defaultCacheTTL    = 5 * time.Minute
+	defaultNotFoundTTL = time.Minute
+	revalidateTimeout  = 30 * time.Second
+)
+
+// Cache stores encoded responses on behalf of a ResponseCache. Implementations must be safe for
+// concurrent use and should drop entries once their TTL has passed.
+type Cache interface {
+	// Get returns the value stored under key, reporting false if there is no live entry.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores value under key for the given TTL.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete removes the entry stored under key, if any.
+	Delete(ctx context.Context, key string) error
+}
+
+// ResponseCache stores responses returned by the data service so repeated requests can be served
+// without a round trip. Entries are keyed by method name and the full request message and held in a
+// Cache, by default an in-memory MemoryCache. A single ResponseCache can be shared between all
+// caching client decorators.
+type ResponseCache struct {
+	cache       Cache
+	size        int
+	ttl         time.Duration
+	methodTTLs  map[string]time.Duration
+	notFoundTTL time.Duration
+	policy      TTLPolicy
+
+	staleWhileRevalidate time.Duration
+	staleIfError         time.Duration
+	refreshing           sync.Map
+	stats                map[string]*methodCounters
+	clock                func() time.Time
+}
+
+// CacheOption configures a ResponseCache.
+type CacheOption func(c *ResponseCache)
+
+// WithCache sets the Cache responses are stored in, such as a FileCache to keep responses across
+// restarts.
+func WithCache(cache Cache) CacheOption {
+	return func(c *ResponseCache) {
+		c.cache = cache
+	}
+}
+
+// WithCacheSize sets the maximum number of entries held by the default in-memory cache.
+func WithCacheSize(size int) CacheOption {
+	return func(c *ResponseCache) {
+		c.size = size
+	}
+}
+
+// WithCacheTTL sets the TTL applied to methods without a TTL of their own.
+func WithCacheTTL(ttl time.Duration) CacheOption {
+	return func(c *ResponseCache) {
+		c.ttl = ttl
+	}
+}
+
+// WithMethodTTL sets the TTL for a single method, e.g. MethodFixtureSearch. A TTL of zero or less
+// disables caching for the method.
+func WithMethodTTL(method string, ttl time.Duration) CacheOption {
+	return func(c *ResponseCache) {
+		c.methodTTLs[method] = ttl
+	}
+}
+
+// WithNotFoundTTL sets how long ErrorNotFound responses are cached for. A TTL of zero or less disables
+// negative caching.
+func WithNotFoundTTL(ttl time.Duration) CacheOption {
+	return func(c *ResponseCache) {
+		c.notFoundTTL = ttl
+	}
+}
+
+// WithTTLPolicy sets a policy deciding TTLs from the content of each response, such as a
+// FixtureTTLPolicy. Methods the policy has no opinion on use the configured method TTLs. A
+// FixtureTTLPolicy derives the status of fixtures using the cache's clock.
+func WithTTLPolicy(p TTLPolicy) CacheOption {
+	return func(c *ResponseCache) {
+		c.policy = p
+	}
+}
+
+// WithStaleWhileRevalidate serves entries up to window past their TTL immediately, refreshing them
+// in the background. Stale responses are flagged on the call's ResponseInfo.
+func WithStaleWhileRevalidate(window time.Duration) CacheOption {
+	return func(c *ResponseCache) {
+		c.staleWhileRevalidate = window
+	}
+}
+
+// WithStaleIfError serves entries up to window past their TTL when the data service fails with an
+// ErrorBadGateway or ErrorExternalServer. Stale responses are flagged on the call's ResponseInfo.
+func WithStaleIfError(window time.Duration) CacheOption {
+	return func(c *ResponseCache) {
+		c.staleIfError = window
+	}
+}
+
+func (c *ResponseCache) ttlFor(ctx context.Context, method string, req proto.Message, res []proto.Message) time.Duration {
+	if c.policy != nil {
+		if ttl, ok := c.policy.TTL(ctx, method, req, res); ok {
+			return ttl
+		}
+	}
+
+	if ttl, ok := c.methodTTLs[method]; ok {
+		return ttl
+	}
+
+	return c.ttl
+}
+
+// load reads an entry from the underlying cache. A failing cache is treated as a miss so the data
+// service is still called.
+func (c *ResponseCache) load(ctx context.Context, key string) ([]byte, bool) {
+	b, ok, err := c.cache.Get(ctx, key)
+
+	if err != nil {
+		return nil, false
+	}
+
+	return b, ok
+}
+
+// store writes an entry to the underlying cache, retaining it past its TTL for as long as it may
+// still be served stale.
+func (c *ResponseCache) store(ctx context.Context, key string, value []byte, ttl time.Duration) {
+	_ = c.cache.Set(ctx, key, value, ttl+max(c.staleWhileRevalidate, c.staleIfError))
+}
+
+// cacheKey derives a key from the scope of the request, the method name and a deterministic encoding
+// of the request message. The request is cloned first as marshalling records size information on the
+// message itself.
+func cacheKey(method string, req proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(proto.Clone(req))
+
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+
+	return scopeOf(req) + "/" + method + "/" + hex.EncodeToString(sum[:]), nil
+}
+
+const (
+	entryMessages byte = iota
+	entryNotFound
+)
+
+// Entries are encoded as a type byte and the time the entry is fresh until, followed by either the
+// length delimited response messages or the details of a cached ErrorNotFound.
+func entryHeader(typ byte, freshUntil time.Time) []byte {
+	return binary.BigEndian.AppendUint64([]byte{typ}, uint64(freshUntil.UnixNano()))
+}
+
+func encodeMessages[T proto.Message](res []T, freshUntil time.Time) ([]byte, error) {
+	buf := bytes.NewBuffer(entryHeader(entryMessages, freshUntil))
+
+	for _, m := range res {
+		if _, err := protodelim.MarshalTo(buf, m); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+func encodeNotFound(e ErrorNotFound, freshUntil time.Time) []byte {
+	s := status.Convert(e.err)
+
+	buf := entryHeader(entryNotFound, freshUntil)
+	buf = binary.AppendUvarint(buf, e.ID)
+	buf = binary.AppendUvarint(buf, uint64(s.Code()))
+	buf = append(buf, s.Message()...)
+
+	return buf
+}
+
+type cacheEntry[T proto.Message] struct {
+	res        []T
+	err        error
+	freshUntil time.Time
+}
+
+// decodeEntry returns the messages held in an encoded entry, or the ErrorNotFound that was cached in
+// their place.
+func decodeEntry[T proto.Message](b []byte) (*cacheEntry[T], error) {
+	if len(b) < 9 {
+		return nil, errors.New("corrupt cache entry")
+	}
+
+	e := cacheEntry[T]{freshUntil: time.Unix(0, int64(binary.BigEndian.Uint64(b[1:9])))}
+
+	switch b[0] {
+	case entryNotFound:
+		r := bytes.NewReader(b[9:])
+
+		id, err := binary.ReadUvarint(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		code, err := binary.ReadUvarint(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		msg, err := io.ReadAll(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		e.err = ErrorNotFound{ID: id, err: status.Error(codes.Code(code), string(msg))}
+
+		return &e, nil
+	case entryMessages:
+		var zero T
+
+		typ := zero.ProtoReflect().Type()
+		r := bufio.NewReader(bytes.NewReader(b[9:]))
+		e.res = []T{}
+
+		for {
+			m := typ.New().Interface()
+
+			if err := protodelim.UnmarshalFrom(r, m); err != nil {
+				if err == io.EOF {
+					return &e, nil
+				}
+
+				return nil, err
+			}
+
+			e.res = append(e.res, m.(T))
+		}
+	}
+
+	return nil, errors.New("unknown cache entry type")
+}
+
+// cachedMany serves a streaming method from the cache, calling through and populating the cache on
+// a miss. Errors other than ErrorNotFound are never cached and are returned as the wrapped client
+// returned them, unless a stale entry can be served in their place.
+func cachedMany[T proto.Message](ctx context.Context, c *ResponseCache, method string, req proto.Message, call func(ctx context.Context) ([]T, error)) ([]T, error) {
+	key, err := cacheKey(method, req)
+
+	if err != nil {
+		return call(ctx)
+	}
+
+	info := responseInfoFrom(ctx)
+	counters := c.counters(method)
+
+	var stale *cacheEntry[T]
+
+	if b, ok := c.load(ctx, key); ok {
+		if e, err := decodeEntry[T](b); err == nil {
+			age := c.clock().Sub(e.freshUntil)
+
+			switch {
+			case age < 0:
+				counters.hits.Add(1)
+				info.served(false)
+				return e.res, e.err
+			case age < c.staleWhileRevalidate:
+				counters.staleHits.Add(1)
+				info.served(true)
+				revalidate(ctx, c, key, method, req, call)
+				return e.res, e.err
+			case age < c.staleIfError:
+				stale = e
+			}
+		}
+	}
+
+	counters.misses.Add(1)
+
+	res, err := call(ctx)
+
+	if err != nil && stale != nil && isUpstreamFailure(err) {
+		counters.staleHits.Add(1)
+		info.served(true)
+		return stale.res, stale.err
+	}
+
+	save(ctx, c, key, method, req, res, err)
+
+	return res, err
+}
+
+// cachedOne is the unary counterpart of cachedMany.
+func cachedOne[T proto.Message](ctx context.Context, c *ResponseCache, method string, req proto.Message, call func(ctx context.Context) (T, error)) (T, error) {
+	res, err := cachedMany(ctx, c, method, req, func(ctx context.Context) ([]T, error) {
+		res, err := call(ctx)
+
+		return []T{res}, err
+	})
+
+	if len(res) == 0 {
+		var zero T
+
+		return zero, err
+	}
+
+	return res[0], err
+}
+
+func save[T proto.Message](ctx context.Context, c *ResponseCache, key, method string, req proto.Message, res []T, err error) {
+	if err != nil {
+		var nf ErrorNotFound
+
+		if errors.As(err, &nf) && c.notFoundTTL > 0 {
+			c.store(ctx, key, encodeNotFound(nf, c.clock().Add(c.notFoundTTL)), c.notFoundTTL)
+		}
+
+		return
+	}
+
+	msgs := make([]proto.Message, len(res))
+
+	for i, m := range res {
+		if !m.ProtoReflect().IsValid() {
+			return
+		}
+
+		msgs[i] = m
+	}
+
+	ttl := c.ttlFor(ctx, method, req, msgs)
+
+	if ttl <= 0 {
+		return
+	}
+
+	if b, err := encodeMessages(res, c.clock().Add(ttl)); err == nil {
+		c.store(ctx, key, b, ttl)
+	}
+}
+
+// revalidate refreshes an entry in the background, detached from the cancellation of the call that
+// served it stale. Only one refresh per key runs at a time.
+func revalidate[T proto.Message](ctx context.Context, c *ResponseCache, key, method string, req proto.Message, call func(ctx context.Context) ([]T, error)) {
+	if _, running := c.refreshing.LoadOrStore(key, struct{}{}); running {
+		return
+	}
+
+	ctx = context.WithValue(context.WithoutCancel(ctx), responseInfoKey{}, (*ResponseInfo)(nil))
+
+	go func() {
+		defer c.refreshing.Delete(key)
+
+		ctx, cancel := context.WithTimeout(ctx, revalidateTimeout)
+		defer cancel()
+
+		res, err := call(ctx)
+
+		save(ctx, c, key, method, req, res, err)
+	}()
+}
+
+func isUpstreamFailure(err error) bool {
+	var bg ErrorBadGateway
+	var es ErrorExternalServer
+
+	return errors.As(err, &bg) || errors.As(err, &es)
+}
+
+func isNotFound(err error) bool {
+	var nf ErrorNotFound
+
+	return errors.As(err, &nf)
+}
+
+// NewResponseCache creates a ResponseCache holding up to 1000 entries in memory for five minutes each,
+// with ErrorNotFound responses cached for one minute, unless configured otherwise.
+func NewResponseCache(opts ...CacheOption) *ResponseCache {
+	c := &ResponseCache{
+		size:        defaultCacheSize,
+		ttl:         defaultCacheTTL,
+		methodTTLs:  map[string]time.Duration{},
+		notFoundTTL: defaultNotFoundTTL,
+		stats:       map[string]*methodCounters{},
+		clock:       time.Now,
+	}
+
+	for _, method := range Methods() {
+		c.stats[method] = &methodCounters{}
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.cache == nil {
+		m := NewMemoryCache(c.size)
+		m.clock = c.clock
+		c.cache = m
+	}
+
+	if p, ok := c.policy.(*FixtureTTLPolicy); ok {
+		p.clock = c.clock
+	}
+
+	if n, ok := c.cache.(EvictionNotifier); ok {
+		n.OnEvict(c.evicted)
+	}
+
+	return c
+}
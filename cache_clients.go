@@ -0,0 +1,169 @@
+package statisticofootballdata
+
+import (
+	"context"
+	statistico "github.com/statistico/statistico-proto/go"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type cachedCompetitionClient struct {
+	client CompetitionClient
+	cache  *ResponseCache
+}
+
+func (c *cachedCompetitionClient) ByCountryID(ctx context.Context, countryId uint64) ([]*statistico.Competition, error) {
+	req := statistico.CompetitionRequest{CountryIds: []uint64{countryId}}
+
+	return cachedMany(ctx, c.cache, MethodCompetitionByCountryID, &req, func(ctx context.Context) ([]*statistico.Competition, error) {
+		return c.client.ByCountryID(ctx, countryId)
+	})
+}
+
+// NewCachedCompetitionClient returns a CompetitionClient serving responses from the cache where possible.
+func NewCachedCompetitionClient(c CompetitionClient, cache *ResponseCache) CompetitionClient {
+	return &cachedCompetitionClient{client: c, cache: cache}
+}
+
+type cachedEventClient struct {
+	client EventClient
+	cache  *ResponseCache
+}
+
+func (e *cachedEventClient) FixtureEvents(ctx context.Context, fixtureID uint64) (*statistico.FixtureEventsResponse, error) {
+	req := statistico.FixtureRequest{FixtureId: fixtureID}
+
+	return cachedOne(ctx, e.cache, MethodEventFixtureEvents, &req, func(ctx context.Context) (*statistico.FixtureEventsResponse, error) {
+		return e.client.FixtureEvents(ctx, fixtureID)
+	})
+}
+
+// NewCachedEventClient returns an EventClient serving responses from the cache where possible.
+func NewCachedEventClient(c EventClient, cache *ResponseCache) EventClient {
+	return &cachedEventClient{client: c, cache: cache}
+}
+
+type cachedFixtureClient struct {
+	client FixtureClient
+	cache  *ResponseCache
+}
+
+func (f *cachedFixtureClient) Search(ctx context.Context, req *statistico.FixtureSearchRequest) ([]*statistico.Fixture, error) {
+	return cachedMany(ctx, f.cache, MethodFixtureSearch, req, func(ctx context.Context) ([]*statistico.Fixture, error) {
+		return f.client.Search(ctx, req)
+	})
+}
+
+func (f *cachedFixtureClient) ByID(ctx context.Context, fixtureID uint64) (*statistico.Fixture, error) {
+	req := statistico.FixtureRequest{FixtureId: fixtureID}
+
+	return cachedOne(ctx, f.cache, MethodFixtureByID, &req, func(ctx context.Context) (*statistico.Fixture, error) {
+		return f.client.ByID(ctx, fixtureID)
+	})
+}
+
+// NewCachedFixtureClient returns a FixtureClient serving responses from the cache where possible.
+func NewCachedFixtureClient(c FixtureClient, cache *ResponseCache) FixtureClient {
+	return &cachedFixtureClient{client: c, cache: cache}
+}
+
+type cachedPlayerClient struct {
+	client PlayerClient
+	cache  *ResponseCache
+}
+
+func (p *cachedPlayerClient) ByID(ctx context.Context, id uint64) (*statistico.Player, error) {
+	req := statistico.PlayerRequest{PlayerId: id}
+
+	return cachedOne(ctx, p.cache, MethodPlayerByID, &req, func(ctx context.Context) (*statistico.Player, error) {
+		return p.client.ByID(ctx, id)
+	})
+}
+
+// NewCachedPlayerClient returns a PlayerClient serving responses from the cache where possible.
+func NewCachedPlayerClient(c PlayerClient, cache *ResponseCache) PlayerClient {
+	return &cachedPlayerClient{client: c, cache: cache}
+}
+
+type cachedPlayerStatsClient struct {
+	client PlayerStatsClient
+	cache  *ResponseCache
+}
+
+func (p *cachedPlayerStatsClient) FixtureStats(ctx context.Context, req *statistico.FixtureRequest) (*statistico.PlayerStatsResponse, error) {
+	return cachedOne(ctx, p.cache, MethodPlayerStatsFixtureStats, req, func(ctx context.Context) (*statistico.PlayerStatsResponse, error) {
+		return p.client.FixtureStats(ctx, req)
+	})
+}
+
+// NewCachedPlayerStatsClient returns a PlayerStatsClient serving responses from the cache where possible.
+func NewCachedPlayerStatsClient(c PlayerStatsClient, cache *ResponseCache) PlayerStatsClient {
+	return &cachedPlayerStatsClient{client: c, cache: cache}
+}
+
+type cachedSeasonClient struct {
+	client SeasonClient
+	cache  *ResponseCache
+}
+
+func (s *cachedSeasonClient) ByTeamID(ctx context.Context, teamId uint64, sort string) ([]*statistico.Season, error) {
+	req := statistico.TeamSeasonsRequest{TeamId: teamId, Sort: &wrapperspb.StringValue{Value: sort}}
+
+	return cachedMany(ctx, s.cache, MethodSeasonByTeamID, &req, func(ctx context.Context) ([]*statistico.Season, error) {
+		return s.client.ByTeamID(ctx, teamId, sort)
+	})
+}
+
+func (s *cachedSeasonClient) ByCompetitionID(ctx context.Context, competitionId uint64, sort string) ([]*statistico.Season, error) {
+	req := statistico.SeasonCompetitionRequest{CompetitionId: competitionId, Sort: &wrapperspb.StringValue{Value: sort}}
+
+	return cachedMany(ctx, s.cache, MethodSeasonByCompetitionID, &req, func(ctx context.Context) ([]*statistico.Season, error) {
+		return s.client.ByCompetitionID(ctx, competitionId, sort)
+	})
+}
+
+// NewCachedSeasonClient returns a SeasonClient serving responses from the cache where possible.
+func NewCachedSeasonClient(c SeasonClient, cache *ResponseCache) SeasonClient {
+	return &cachedSeasonClient{client: c, cache: cache}
+}
+
+type cachedTeamClient struct {
+	client TeamClient
+	cache  *ResponseCache
+}
+
+func (t *cachedTeamClient) ByID(ctx context.Context, teamID uint64) (*statistico.Team, error) {
+	req := statistico.TeamRequest{TeamId: teamID}
+
+	return cachedOne(ctx, t.cache, MethodTeamByID, &req, func(ctx context.Context) (*statistico.Team, error) {
+		return t.client.ByID(ctx, teamID)
+	})
+}
+
+func (t *cachedTeamClient) BySeasonID(ctx context.Context, seasonId uint64) ([]*statistico.Team, error) {
+	req := statistico.SeasonTeamsRequest{SeasonId: seasonId}
+
+	return cachedMany(ctx, t.cache, MethodTeamBySeasonID, &req, func(ctx context.Context) ([]*statistico.Team, error) {
+		return t.client.BySeasonID(ctx, seasonId)
+	})
+}
+
+// NewCachedTeamClient returns a TeamClient serving responses from the cache where possible.
+func NewCachedTeamClient(c TeamClient, cache *ResponseCache) TeamClient {
+	return &cachedTeamClient{client: c, cache: cache}
+}
+
+type cachedTeamStatClient struct {
+	client TeamStatClient
+	cache  *ResponseCache
+}
+
+func (t *cachedTeamStatClient) Stats(ctx context.Context, req *statistico.FixtureRequest) (*statistico.TeamStatsResponse, error) {
+	return cachedOne(ctx, t.cache, MethodTeamStatStats, req, func(ctx context.Context) (*statistico.TeamStatsResponse, error) {
+		return t.client.Stats(ctx, req)
+	})
+}
+
+// NewCachedTeamStatClient returns a TeamStatClient serving responses from the cache where possible.
+func NewCachedTeamStatClient(c TeamStatClient, cache *ResponseCache) TeamStatClient {
+	return &cachedTeamStatClient{client: c, cache: cache}
+}
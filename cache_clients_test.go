@@ -0,0 +1,291 @@
+package statisticofootballdata_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"io"
+	"testing"
+)
+
+func TestCachedCompetitionClient_ByCountryID(t *testing.T) {
+	t.Run("serves competitions from the cache after the first call", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoCompetitionClient)
+		client := statisticofootballdata.NewCachedCompetitionClient(
+			statisticofootballdata.NewCompetitionClient(m),
+			statisticofootballdata.NewResponseCache(),
+		)
+
+		stream := new(MockCompetitionStream)
+		ctx := context.Background()
+
+		m.On("ListCompetitions", ctx, &statistico.CompetitionRequest{CountryIds: []uint64{462}}, []grpc.CallOption(nil)).Return(stream, nil)
+		stream.On("Recv").Once().Return(&statistico.Competition{Id: 8}, nil)
+		stream.On("Recv").Once().Return(&statistico.Competition{}, io.EOF)
+
+		for i := 0; i < 2; i++ {
+			competitions, err := client.ByCountryID(ctx, 462)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, 1, len(competitions))
+			assert.Equal(t, uint64(8), competitions[0].GetId())
+		}
+
+		m.AssertNumberOfCalls(t, "ListCompetitions", 1)
+	})
+}
+
+func TestCachedEventClient_FixtureEvents(t *testing.T) {
+	t.Run("serves fixture events from the cache after the first call", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoEventClient)
+		client := statisticofootballdata.NewCachedEventClient(
+			statisticofootballdata.NewEventClient(m),
+			statisticofootballdata.NewResponseCache(),
+		)
+
+		ctx := context.Background()
+
+		m.On("FixtureEvents", ctx, &statistico.FixtureRequest{FixtureId: 192}, []grpc.CallOption(nil)).
+			Return(&statistico.FixtureEventsResponse{FixtureId: 192}, nil)
+
+		for i := 0; i < 2; i++ {
+			res, err := client.FixtureEvents(ctx, 192)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, uint64(192), res.GetFixtureId())
+		}
+
+		m.AssertNumberOfCalls(t, "FixtureEvents", 1)
+	})
+}
+
+func TestCachedFixtureClient_ByID(t *testing.T) {
+	t.Run("serves fixtures from the cache after the first call", func(t *testing.T) {
+		t.Helper()
+
+		pc := new(MockFixtureProtoClient)
+		client := statisticofootballdata.NewCachedFixtureClient(
+			statisticofootballdata.NewFixtureClient(pc),
+			statisticofootballdata.NewResponseCache(),
+		)
+
+		ctx := context.Background()
+
+		pc.On("FixtureByID", ctx, &statistico.FixtureRequest{FixtureId: 5}, []grpc.CallOption(nil)).Return(&statistico.Fixture{Id: 5}, nil)
+
+		for i := 0; i < 2; i++ {
+			fixture, err := client.ByID(ctx, 5)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, int64(5), fixture.GetId())
+		}
+
+		pc.AssertNumberOfCalls(t, "FixtureByID", 1)
+	})
+}
+
+func TestCachedPlayerClient_ByID(t *testing.T) {
+	t.Run("serves players from the cache after the first call", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoPlayerClient)
+		client := statisticofootballdata.NewCachedPlayerClient(
+			statisticofootballdata.NewPlayerClient(m),
+			statisticofootballdata.NewResponseCache(),
+		)
+
+		ctx := context.Background()
+
+		m.On("GetPlayerByID", ctx, &statistico.PlayerRequest{PlayerId: 99}, []grpc.CallOption(nil)).Return(&statistico.Player{Id: 99}, nil)
+
+		for i := 0; i < 2; i++ {
+			player, err := client.ByID(ctx, 99)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, uint64(99), player.GetId())
+		}
+
+		m.AssertNumberOfCalls(t, "GetPlayerByID", 1)
+	})
+}
+
+func TestCachedPlayerStatsClient_FixtureStats(t *testing.T) {
+	t.Run("serves player stats from the cache after the first call", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoPlayerStatsClient)
+		client := statisticofootballdata.NewCachedPlayerStatsClient(
+			statisticofootballdata.NewPlayerStatsClient(m),
+			statisticofootballdata.NewResponseCache(),
+		)
+
+		ctx := context.Background()
+		req := statistico.FixtureRequest{FixtureId: 192}
+
+		res := statistico.PlayerStatsResponse{
+			HomeTeam: []*statistico.PlayerStats{{PlayerId: 1}},
+			AwayTeam: []*statistico.PlayerStats{{PlayerId: 2}},
+		}
+
+		m.On("GetPlayerStatsForFixture", ctx, &req, []grpc.CallOption(nil)).Return(&res, nil)
+
+		for i := 0; i < 2; i++ {
+			stats, err := client.FixtureStats(ctx, &req)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, uint64(1), stats.GetHomeTeam()[0].GetPlayerId())
+			assert.Equal(t, uint64(2), stats.GetAwayTeam()[0].GetPlayerId())
+		}
+
+		m.AssertNumberOfCalls(t, "GetPlayerStatsForFixture", 1)
+	})
+}
+
+func TestCachedSeasonClient(t *testing.T) {
+	t.Run("serves seasons for a team from the cache after the first call", func(t *testing.T) {
+		t.Helper()
+
+		s := new(MockProtoSeasonClient)
+		client := statisticofootballdata.NewCachedSeasonClient(
+			statisticofootballdata.NewSeasonClient(s),
+			statisticofootballdata.NewResponseCache(),
+		)
+
+		ctx := context.Background()
+
+		req := statistico.TeamSeasonsRequest{TeamId: 55, Sort: &wrapperspb.StringValue{Value: "name_desc"}}
+		res := statistico.TeamSeasonsResponse{Seasons: []*statistico.Season{newProtoSeason(), newProtoSeason()}}
+
+		s.On("GetSeasonsForTeam", ctx, &req, []grpc.CallOption(nil)).Return(&res, nil)
+
+		for i := 0; i < 2; i++ {
+			seasons, err := client.ByTeamID(ctx, 55, "name_desc")
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, 2, len(seasons))
+		}
+
+		s.AssertNumberOfCalls(t, "GetSeasonsForTeam", 1)
+	})
+
+	t.Run("serves seasons for a competition from the cache after the first call", func(t *testing.T) {
+		t.Helper()
+
+		s := new(MockProtoSeasonClient)
+		client := statisticofootballdata.NewCachedSeasonClient(
+			statisticofootballdata.NewSeasonClient(s),
+			statisticofootballdata.NewResponseCache(),
+		)
+
+		stream := new(MockSeasonStream)
+		ctx := context.Background()
+
+		req := statistico.SeasonCompetitionRequest{CompetitionId: 8, Sort: &wrapperspb.StringValue{Value: "name_desc"}}
+
+		s.On("GetSeasonsForCompetition", ctx, &req, []grpc.CallOption(nil)).Return(stream, nil)
+		stream.On("Recv").Once().Return(newProtoSeason(), nil)
+		stream.On("Recv").Once().Return(&statistico.Season{}, io.EOF)
+
+		for i := 0; i < 2; i++ {
+			seasons, err := client.ByCompetitionID(ctx, 8, "name_desc")
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, 1, len(seasons))
+		}
+
+		s.AssertNumberOfCalls(t, "GetSeasonsForCompetition", 1)
+	})
+}
+
+func TestCachedTeamClient_BySeasonID(t *testing.T) {
+	t.Run("serves teams from the cache after the first call", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewCachedTeamClient(
+			statisticofootballdata.NewTeamClient(m),
+			statisticofootballdata.NewResponseCache(),
+		)
+
+		stream := new(MockTeamStream)
+		ctx := context.Background()
+
+		m.On("GetTeamsBySeasonId", ctx, &statistico.SeasonTeamsRequest{SeasonId: 16036}, []grpc.CallOption(nil)).Return(stream, nil)
+		stream.On("Recv").Twice().Return(&statistico.Team{Id: 1}, nil)
+		stream.On("Recv").Once().Return(&statistico.Team{}, io.EOF)
+
+		for i := 0; i < 2; i++ {
+			teams, err := client.BySeasonID(ctx, 16036)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, 2, len(teams))
+		}
+
+		m.AssertNumberOfCalls(t, "GetTeamsBySeasonId", 1)
+	})
+}
+
+func TestCachedTeamStatClient_Stats(t *testing.T) {
+	t.Run("serves team stats from the cache after the first call", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamStatsClient)
+		client := statisticofootballdata.NewCachedTeamStatClient(
+			statisticofootballdata.NewTeamStatClient(m),
+			statisticofootballdata.NewResponseCache(),
+		)
+
+		ctx := context.Background()
+		req := statistico.FixtureRequest{FixtureId: 192}
+
+		res := statistico.TeamStatsResponse{
+			HomeTeam: &statistico.TeamStats{TeamId: 1},
+			AwayTeam: &statistico.TeamStats{TeamId: 2},
+		}
+
+		m.On("GetTeamStatsForFixture", ctx, &req, []grpc.CallOption(nil)).Return(&res, nil)
+
+		for i := 0; i < 2; i++ {
+			stats, err := client.Stats(ctx, &req)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, uint64(1), stats.GetHomeTeam().GetTeamId())
+		}
+
+		m.AssertNumberOfCalls(t, "GetTeamStatsForFixture", 1)
+	})
+}
@@ -0,0 +1,188 @@
+package statisticofootballdata
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileCache is a Cache storing each entry as a file beneath a directory, so cached responses survive
+// restarts and can be shared by jobs running on the same host.
+type FileCache struct {
+	dir   string
+	clock func() time.Time
+}
+
+func (f *FileCache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	b, err := os.ReadFile(f.path(key))
+
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	k, value, expires, err := decodeFileEntry(b)
+
+	if err != nil || k != key {
+		return nil, false, err
+	}
+
+	if !f.clock().Before(expires) {
+		return nil, false, f.Delete(context.Background(), key)
+	}
+
+	return value, true, nil
+}
+
+func (f *FileCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	path := f.path(key)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(encodeFileEntry(key, value, f.clock().Add(ttl))); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (f *FileCache) Delete(_ context.Context, key string) error {
+	if err := os.Remove(f.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (f *FileCache) Keys(ctx context.Context, prefix string) ([]string, error) {
+	keys := []string{}
+
+	err := f.walk(ctx, func(_, key string, expires time.Time) error {
+		if strings.HasPrefix(key, prefix) && f.clock().Before(expires) {
+			keys = append(keys, key)
+		}
+
+		return nil
+	})
+
+	return keys, err
+}
+
+// Prune removes expired entries from disk. Expired entries are otherwise only removed when read.
+func (f *FileCache) Prune(ctx context.Context) error {
+	return f.walk(ctx, func(path, _ string, expires time.Time) error {
+		if f.clock().Before(expires) {
+			return nil
+		}
+
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// walk calls fn with the path, key and expiry of every entry held on disk.
+func (f *FileCache) walk(ctx context.Context, fn func(path, key string, expires time.Time) error) error {
+	return filepath.WalkDir(f.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if d.IsDir() || filepath.Base(path)[0] == '.' {
+			return nil
+		}
+
+		b, err := os.ReadFile(path)
+
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			return err
+		}
+
+		key, _, expires, err := decodeFileEntry(b)
+
+		if err != nil {
+			return nil
+		}
+
+		return fn(path, key, expires)
+	})
+}
+
+// path spreads entries across sub directories named after the first byte of the hashed key.
+func (f *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	name := hex.EncodeToString(sum[:])
+
+	return filepath.Join(f.dir, name[:2], name)
+}
+
+func encodeFileEntry(key string, value []byte, expires time.Time) []byte {
+	b := binary.BigEndian.AppendUint64(nil, uint64(expires.UnixNano()))
+	b = binary.AppendUvarint(b, uint64(len(key)))
+	b = append(b, key...)
+
+	return append(b, value...)
+}
+
+func decodeFileEntry(b []byte) (string, []byte, time.Time, error) {
+	if len(b) < 8 {
+		return "", nil, time.Time{}, errors.New("corrupt cache file")
+	}
+
+	expires := time.Unix(0, int64(binary.BigEndian.Uint64(b)))
+
+	n, read := binary.Uvarint(b[8:])
+
+	if read <= 0 || uint64(len(b)-8-read) < n {
+		return "", nil, time.Time{}, errors.New("corrupt cache file")
+	}
+
+	start := 8 + read
+	end := start + int(n)
+
+	return string(b[start:end]), b[end:], expires, nil
+}
+
+// NewFileCache creates a FileCache storing entries beneath dir, creating the directory if required.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &FileCache{dir: dir, clock: time.Now}, nil
+}
@@ -0,0 +1,159 @@
+package statisticofootballdata_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileCache(t *testing.T) {
+	t.Run("persists values across instances", func(t *testing.T) {
+		t.Helper()
+
+		dir := t.TempDir()
+		ctx := context.Background()
+
+		first, err := statisticofootballdata.NewFileCache(dir)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		_ = first.Set(ctx, "a", []byte("value"), time.Minute)
+
+		second, _ := statisticofootballdata.NewFileCache(dir)
+
+		value, ok, err := second.Get(ctx, "a")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.True(t, ok)
+		assert.Equal(t, []byte("value"), value)
+	})
+
+	t.Run("drops expired values", func(t *testing.T) {
+		t.Helper()
+
+		clock := newTestClock()
+		cache, _ := statisticofootballdata.NewFileCache(t.TempDir())
+		cache.SetClock(clock.Now)
+		ctx := context.Background()
+
+		_ = cache.Set(ctx, "a", []byte("value"), time.Millisecond)
+		clock.Advance(5 * time.Millisecond)
+
+		_, ok, err := cache.Get(ctx, "a")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.False(t, ok)
+	})
+
+	t.Run("deletes values", func(t *testing.T) {
+		t.Helper()
+
+		cache, _ := statisticofootballdata.NewFileCache(t.TempDir())
+		ctx := context.Background()
+
+		_ = cache.Set(ctx, "a", []byte("value"), time.Minute)
+
+		if err := cache.Delete(ctx, "a"); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		_, ok, _ := cache.Get(ctx, "a")
+
+		assert.False(t, ok)
+		assert.NoError(t, cache.Delete(ctx, "missing"))
+	})
+
+	t.Run("prunes expired values from disk", func(t *testing.T) {
+		t.Helper()
+
+		dir := t.TempDir()
+		clock := newTestClock()
+		cache, _ := statisticofootballdata.NewFileCache(dir)
+		cache.SetClock(clock.Now)
+		ctx := context.Background()
+
+		_ = cache.Set(ctx, "a", []byte("value"), time.Millisecond)
+		_ = cache.Set(ctx, "b", []byte("value"), time.Minute)
+		clock.Advance(5 * time.Millisecond)
+
+		if err := cache.Prune(ctx); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		files := 0
+
+		_ = filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+			if !d.IsDir() {
+				files++
+			}
+
+			return nil
+		})
+
+		assert.Equal(t, 1, files)
+	})
+
+	t.Run("lists live keys beginning with a prefix", func(t *testing.T) {
+		t.Helper()
+
+		cache, _ := statisticofootballdata.NewFileCache(t.TempDir())
+		ctx := context.Background()
+
+		_ = cache.Set(ctx, "team/1/a", []byte("a"), time.Minute)
+		_ = cache.Set(ctx, "team/2/a", []byte("a"), time.Minute)
+		_ = cache.Set(ctx, "team/1/b", []byte("b"), time.Nanosecond)
+
+		keys, err := cache.Keys(ctx, "team/1/")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []string{"team/1/a"}, keys)
+	})
+
+	t.Run("serves responses cached before a restart", func(t *testing.T) {
+		t.Helper()
+
+		dir := t.TempDir()
+		ctx := context.Background()
+
+		m := new(MockProtoTeamClient)
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).
+			Return(&statistico.Team{Id: 1, Name: "West Ham United"}, nil)
+
+		for i := 0; i < 2; i++ {
+			store, _ := statisticofootballdata.NewFileCache(dir)
+
+			client := statisticofootballdata.NewCachedTeamClient(
+				statisticofootballdata.NewTeamClient(m),
+				statisticofootballdata.NewResponseCache(statisticofootballdata.WithCache(store)),
+			)
+
+			team, err := client.ByID(ctx, 1)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, "West Ham United", team.GetName())
+		}
+
+		m.AssertNumberOfCalls(t, "GetTeamByID", 1)
+	})
+}
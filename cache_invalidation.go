@@ -0,0 +1,133 @@
+package statisticofootballdata
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/protobuf/proto"
+	"strconv"
+	"strings"
+)
+
+// ErrCacheNotListable is returned when an operation needs to enumerate the keys of a Cache that does
+// not implement KeyLister.
+var ErrCacheNotListable = errors.New("cache does not support listing keys")
+
+// KeyLister is implemented by caches able to enumerate their keys, which ResponseCache requires for
+// invalidation, purging and per method entry counts.
+type KeyLister interface {
+	// Keys returns the keys of all live entries beginning with prefix.
+	Keys(ctx context.Context, prefix string) ([]string, error)
+}
+
+// CacheKind identifies the resource a cache entry is scoped to.
+type CacheKind string
+
+// Cache entries are scoped to the resource identified by their request. Searches are scoped to their
+// season when filtering on a single season and to their team when filtering on a team, otherwise they
+// are unscoped.
+const (
+	CacheKindCompetition CacheKind = "competition"
+	CacheKindCountry     CacheKind = "country"
+	CacheKindFixture     CacheKind = "fixture"
+	CacheKindPlayer      CacheKind = "player"
+	CacheKindSeason      CacheKind = "season"
+	CacheKindTeam        CacheKind = "team"
+
+	unscoped = "search/-"
+)
+
+// ScopePrefix returns the key prefix shared by all entries scoped to a resource, for use with Purge.
+func ScopePrefix(kind CacheKind, id uint64) string {
+	return string(kind) + "/" + strconv.FormatUint(id, 10) + "/"
+}
+
+// scopeOf returns the scope of the entry caching the response to req, the resource identified by the
+// first of its fields to hold a single ID.
+func scopeOf(req proto.Message) string {
+	for _, f := range RequestFields(req) {
+		if len(f.IDs) == 1 {
+			return strings.TrimSuffix(ScopePrefix(f.Kind, f.IDs[0]), "/")
+		}
+	}
+
+	return unscoped
+}
+
+// methodOf returns the method an entry was cached for from its key.
+func methodOf(key string) string {
+	parts := strings.Split(key, "/")
+
+	if len(parts) != 4 {
+		return ""
+	}
+
+	return parts[2]
+}
+
+// Invalidation identifies cached entries to remove, either every entry scoped to the resource
+// identified by Kind and ID or every entry whose key begins with Prefix.
+type Invalidation struct {
+	Kind   CacheKind
+	ID     uint64
+	Prefix string
+}
+
+// Invalidate removes every entry scoped to a resource, e.g. all cached data for a fixture including
+// its events and stats. Cached searches containing a fixture are scoped to their season or team so
+// are not removed when the fixture is invalidated.
+func (c *ResponseCache) Invalidate(ctx context.Context, kind CacheKind, id uint64) error {
+	return c.Purge(ctx, ScopePrefix(kind, id))
+}
+
+// Purge removes every entry whose key begins with prefix. Keys begin with the scope of the entry, so
+// ScopePrefix(CacheKindSeason, id) purges all data for a season, and an empty prefix empties the cache.
+func (c *ResponseCache) Purge(ctx context.Context, prefix string) error {
+	lister, ok := c.cache.(KeyLister)
+
+	if !ok {
+		return ErrCacheNotListable
+	}
+
+	keys, err := lister.Keys(ctx, prefix)
+
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := c.cache.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Apply removes the entries identified by an Invalidation.
+func (c *ResponseCache) Apply(ctx context.Context, inv Invalidation) error {
+	if inv.Kind != "" {
+		return c.Invalidate(ctx, inv.Kind, inv.ID)
+	}
+
+	return c.Purge(ctx, inv.Prefix)
+}
+
+// ListenForInvalidations applies each Invalidation received on ch, allowing an external signal such
+// as a message queue subscription to keep the cache in step with corrections to the data. It blocks
+// until ctx is done or ch is closed, passing any error applying an invalidation to onError if set.
+func (c *ResponseCache) ListenForInvalidations(ctx context.Context, ch <-chan Invalidation, onError func(Invalidation, error)) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case inv, ok := <-ch:
+			if !ok {
+				return
+			}
+
+			if err := c.Apply(ctx, inv); err != nil && onError != nil {
+				onError(inv, err)
+			}
+		}
+	}
+}
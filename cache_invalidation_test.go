@@ -0,0 +1,169 @@
+package statisticofootballdata_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"io"
+	"testing"
+	"time"
+)
+
+type unlistableCache struct {
+	statisticofootballdata.Cache
+}
+
+func TestResponseCache_Invalidate(t *testing.T) {
+	t.Run("removes every entry scoped to a team", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		s := new(MockProtoSeasonClient)
+		cache := statisticofootballdata.NewResponseCache()
+		teams := statisticofootballdata.NewCachedTeamClient(statisticofootballdata.NewTeamClient(m), cache)
+		seasons := statisticofootballdata.NewCachedSeasonClient(statisticofootballdata.NewSeasonClient(s), cache)
+
+		ctx := context.Background()
+		seasonReq := statistico.TeamSeasonsRequest{TeamId: 1, Sort: &wrapperspb.StringValue{Value: "name_desc"}}
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{Id: 1}, nil)
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 2}, []grpc.CallOption(nil)).Return(&statistico.Team{Id: 2}, nil)
+		s.On("GetSeasonsForTeam", ctx, &seasonReq, []grpc.CallOption(nil)).Return(&statistico.TeamSeasonsResponse{}, nil)
+
+		call := func() {
+			_, _ = teams.ByID(ctx, 1)
+			_, _ = teams.ByID(ctx, 2)
+			_, _ = seasons.ByTeamID(ctx, 1, "name_desc")
+		}
+
+		call()
+
+		if err := cache.Invalidate(ctx, statisticofootballdata.CacheKindTeam, 1); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		call()
+
+		m.AssertNumberOfCalls(t, "GetTeamByID", 3)
+		s.AssertNumberOfCalls(t, "GetSeasonsForTeam", 2)
+	})
+
+	t.Run("returns an error if the cache cannot list keys", func(t *testing.T) {
+		t.Helper()
+
+		cache := statisticofootballdata.NewResponseCache(
+			statisticofootballdata.WithCache(unlistableCache{statisticofootballdata.NewMemoryCache(10)}),
+		)
+
+		err := cache.Invalidate(context.Background(), statisticofootballdata.CacheKindTeam, 1)
+
+		assert.Equal(t, statisticofootballdata.ErrCacheNotListable, err)
+	})
+}
+
+func TestResponseCache_Purge(t *testing.T) {
+	t.Run("purges all data for a season", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		pc := new(MockFixtureProtoClient)
+		cache := statisticofootballdata.NewResponseCache()
+		teams := statisticofootballdata.NewCachedTeamClient(statisticofootballdata.NewTeamClient(m), cache)
+		fixtures := statisticofootballdata.NewCachedFixtureClient(statisticofootballdata.NewFixtureClient(pc), cache)
+
+		ctx := context.Background()
+		search := statistico.FixtureSearchRequest{SeasonIds: []uint64{16036}}
+
+		m.On("GetTeamsBySeasonId", ctx, &statistico.SeasonTeamsRequest{SeasonId: 16036}, []grpc.CallOption(nil)).Return(emptyTeamStream(), nil).Twice()
+		pc.On("Search", ctx, &search, []grpc.CallOption(nil)).Return(emptyFixtureStream(), nil).Twice()
+
+		call := func() {
+			_, _ = teams.BySeasonID(ctx, 16036)
+			_, _ = fixtures.Search(ctx, &search)
+		}
+
+		call()
+
+		if err := cache.Purge(ctx, statisticofootballdata.ScopePrefix(statisticofootballdata.CacheKindSeason, 16036)); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		call()
+
+		m.AssertNumberOfCalls(t, "GetTeamsBySeasonId", 2)
+		pc.AssertNumberOfCalls(t, "Search", 2)
+	})
+
+	t.Run("empties the cache given an empty prefix", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		cache := statisticofootballdata.NewResponseCache()
+		teams := statisticofootballdata.NewCachedTeamClient(statisticofootballdata.NewTeamClient(m), cache)
+
+		ctx := context.Background()
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{Id: 1}, nil)
+
+		_, _ = teams.ByID(ctx, 1)
+		_ = cache.Purge(ctx, "")
+		_, _ = teams.ByID(ctx, 1)
+
+		m.AssertNumberOfCalls(t, "GetTeamByID", 2)
+	})
+}
+
+func TestResponseCache_ListenForInvalidations(t *testing.T) {
+	t.Run("applies invalidations received on the channel", func(t *testing.T) {
+		t.Helper()
+
+		pc := new(MockFixtureProtoClient)
+		cache := statisticofootballdata.NewResponseCache()
+		fixtures := statisticofootballdata.NewCachedFixtureClient(statisticofootballdata.NewFixtureClient(pc), cache)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		pc.On("FixtureByID", ctx, &statistico.FixtureRequest{FixtureId: 5}, []grpc.CallOption(nil)).Return(&statistico.Fixture{Id: 5}, nil)
+
+		_, _ = fixtures.ByID(ctx, 5)
+
+		ch := make(chan statisticofootballdata.Invalidation)
+		done := make(chan struct{})
+
+		go func() {
+			cache.ListenForInvalidations(ctx, ch, nil)
+			close(done)
+		}()
+
+		ch <- statisticofootballdata.Invalidation{Kind: statisticofootballdata.CacheKindFixture, ID: 5}
+		close(ch)
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("Expected listener to return once the channel was closed")
+		}
+
+		_, _ = fixtures.ByID(ctx, 5)
+
+		pc.AssertNumberOfCalls(t, "FixtureByID", 2)
+	})
+}
+
+func emptyTeamStream() *MockTeamStream {
+	stream := new(MockTeamStream)
+	stream.On("Recv").Return(&statistico.Team{}, io.EOF)
+
+	return stream
+}
+
+func emptyFixtureStream() *MockFixtureStream {
+	stream := new(MockFixtureStream)
+	stream.On("Recv").Return(&statistico.Fixture{}, io.EOF)
+
+	return stream
+}
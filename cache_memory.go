@@ -0,0 +1,135 @@
+package statisticofootballdata
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MemoryCache is an in-process Cache bounded by the number of entries it holds, evicting the least
+// recently used entry once full.
+type MemoryCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+	onEvict []func(key string)
+	clock   func() time.Time
+}
+
+type memoryItem struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+func (m *MemoryCache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, ok := m.entries[key]
+
+	if !ok {
+		return nil, false, nil
+	}
+
+	item := el.Value.(*memoryItem)
+
+	if !m.clock().Before(item.expires) {
+		m.remove(el)
+		return nil, false, nil
+	}
+
+	m.order.MoveToFront(el)
+
+	return item.value, true, nil
+}
+
+func (m *MemoryCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	expires := m.clock().Add(ttl)
+
+	if el, ok := m.entries[key]; ok {
+		item := el.Value.(*memoryItem)
+		item.value = value
+		item.expires = expires
+		m.order.MoveToFront(el)
+		return nil
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryItem{key: key, value: value, expires: expires})
+
+	for m.order.Len() > m.size {
+		oldest := m.order.Back()
+		m.remove(oldest)
+
+		for _, fn := range m.onEvict {
+			fn(oldest.Value.(*memoryItem).key)
+		}
+	}
+
+	return nil
+}
+
+func (m *MemoryCache) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if el, ok := m.entries[key]; ok {
+		m.remove(el)
+	}
+
+	return nil
+}
+
+func (m *MemoryCache) Keys(_ context.Context, prefix string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	keys := []string{}
+	now := m.clock()
+
+	for key, el := range m.entries {
+		if strings.HasPrefix(key, prefix) && now.Before(el.Value.(*memoryItem).expires) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, nil
+}
+
+// OnEvict registers fn to be called with the key of each entry evicted to make room for another. It
+// is called while the cache is locked so must not call back into the cache.
+func (m *MemoryCache) OnEvict(fn func(key string)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.onEvict = append(m.onEvict, fn)
+}
+
+// Len returns the number of entries held, including any that have expired but not yet been removed.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.order.Len()
+}
+
+func (m *MemoryCache) remove(el *list.Element) {
+	m.order.Remove(el)
+	delete(m.entries, el.Value.(*memoryItem).key)
+}
+
+// NewMemoryCache creates a MemoryCache holding at most size entries.
+func NewMemoryCache(size int) *MemoryCache {
+	return &MemoryCache{
+		size:    size,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+		clock:   time.Now,
+	}
+}
@@ -0,0 +1,116 @@
+package statisticofootballdata_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache(t *testing.T) {
+	t.Run("stores and returns values", func(t *testing.T) {
+		t.Helper()
+
+		cache := statisticofootballdata.NewMemoryCache(10)
+		ctx := context.Background()
+
+		_ = cache.Set(ctx, "a", []byte("value"), time.Minute)
+
+		value, ok, err := cache.Get(ctx, "a")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.True(t, ok)
+		assert.Equal(t, []byte("value"), value)
+	})
+
+	t.Run("drops expired values", func(t *testing.T) {
+		t.Helper()
+
+		clock := newTestClock()
+		cache := statisticofootballdata.NewMemoryCache(10)
+		cache.SetClock(clock.Now)
+		ctx := context.Background()
+
+		_ = cache.Set(ctx, "a", []byte("value"), time.Millisecond)
+		clock.Advance(5 * time.Millisecond)
+
+		_, ok, _ := cache.Get(ctx, "a")
+
+		assert.False(t, ok)
+		assert.Equal(t, 0, cache.Len())
+	})
+
+	t.Run("evicts the least recently used value once full", func(t *testing.T) {
+		t.Helper()
+
+		cache := statisticofootballdata.NewMemoryCache(2)
+		ctx := context.Background()
+
+		_ = cache.Set(ctx, "a", []byte("a"), time.Minute)
+		_ = cache.Set(ctx, "b", []byte("b"), time.Minute)
+		_, _, _ = cache.Get(ctx, "a")
+		_ = cache.Set(ctx, "c", []byte("c"), time.Minute)
+
+		_, okA, _ := cache.Get(ctx, "a")
+		_, okB, _ := cache.Get(ctx, "b")
+		_, okC, _ := cache.Get(ctx, "c")
+
+		assert.True(t, okA)
+		assert.False(t, okB)
+		assert.True(t, okC)
+		assert.Equal(t, 2, cache.Len())
+	})
+
+	t.Run("deletes values", func(t *testing.T) {
+		t.Helper()
+
+		cache := statisticofootballdata.NewMemoryCache(10)
+		ctx := context.Background()
+
+		_ = cache.Set(ctx, "a", []byte("value"), time.Minute)
+		_ = cache.Delete(ctx, "a")
+
+		_, ok, _ := cache.Get(ctx, "a")
+
+		assert.False(t, ok)
+	})
+
+	t.Run("lists live keys beginning with a prefix", func(t *testing.T) {
+		t.Helper()
+
+		cache := statisticofootballdata.NewMemoryCache(10)
+		ctx := context.Background()
+
+		_ = cache.Set(ctx, "team/1/a", []byte("a"), time.Minute)
+		_ = cache.Set(ctx, "team/2/a", []byte("a"), time.Minute)
+		_ = cache.Set(ctx, "team/1/b", []byte("b"), time.Nanosecond)
+
+		keys, err := cache.Keys(ctx, "team/1/")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []string{"team/1/a"}, keys)
+	})
+
+	t.Run("notifies listeners of evicted keys", func(t *testing.T) {
+		t.Helper()
+
+		cache := statisticofootballdata.NewMemoryCache(1)
+		ctx := context.Background()
+
+		evicted := []string{}
+		cache.OnEvict(func(key string) { evicted = append(evicted, key) })
+
+		_ = cache.Set(ctx, "a", []byte("a"), time.Minute)
+		_ = cache.Set(ctx, "b", []byte("b"), time.Minute)
+		_ = cache.Delete(ctx, "b")
+
+		assert.Equal(t, []string{"a"}, evicted)
+	})
+}
@@ -0,0 +1,83 @@
+package statisticofootballdata
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// EvictionNotifier is implemented by caches evicting entries to stay within a size bound, allowing a
+// ResponseCache to count evictions.
+type EvictionNotifier interface {
+	// OnEvict registers fn to be called with the key of each evicted entry.
+	OnEvict(fn func(key string))
+}
+
+// MethodCacheStats describes the effectiveness of the cache for a single method.
+type MethodCacheStats struct {
+	// Hits counts calls served by a fresh entry.
+	Hits uint64
+	// StaleHits counts calls served by an entry that had passed its TTL.
+	StaleHits uint64
+	// Misses counts calls made to the data service.
+	Misses uint64
+	// Evictions counts entries evicted to keep the cache within its size bound.
+	Evictions uint64
+	// Entries is the number of entries currently cached.
+	Entries int
+}
+
+type methodCounters struct {
+	hits      atomic.Uint64
+	staleHits atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+func (c *ResponseCache) counters(method string) *methodCounters {
+	if m, ok := c.stats[method]; ok {
+		return m
+	}
+
+	return &methodCounters{}
+}
+
+func (c *ResponseCache) evicted(key string) {
+	c.counters(methodOf(key)).evictions.Add(1)
+}
+
+// Stats returns cache statistics for every method. Entry counts require the underlying cache to
+// implement KeyLister, otherwise they are left at zero and ErrCacheNotListable is returned alongside
+// the remaining statistics.
+func (c *ResponseCache) Stats(ctx context.Context) (map[string]MethodCacheStats, error) {
+	stats := make(map[string]MethodCacheStats, len(c.stats))
+
+	for method, m := range c.stats {
+		stats[method] = MethodCacheStats{
+			Hits:      m.hits.Load(),
+			StaleHits: m.staleHits.Load(),
+			Misses:    m.misses.Load(),
+			Evictions: m.evictions.Load(),
+		}
+	}
+
+	lister, ok := c.cache.(KeyLister)
+
+	if !ok {
+		return stats, ErrCacheNotListable
+	}
+
+	keys, err := lister.Keys(ctx, "")
+
+	if err != nil {
+		return stats, err
+	}
+
+	for _, key := range keys {
+		if s, ok := stats[methodOf(key)]; ok {
+			s.Entries++
+			stats[methodOf(key)] = s
+		}
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,62 @@
+package statisticofootballdata_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"testing"
+)
+
+func TestResponseCache_Stats(t *testing.T) {
+	t.Run("returns hits, misses, evictions and entries per method", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		cache := statisticofootballdata.NewResponseCache(statisticofootballdata.WithCacheSize(2))
+		teams := statisticofootballdata.NewCachedTeamClient(statisticofootballdata.NewTeamClient(m), cache)
+
+		ctx := context.Background()
+
+		for _, id := range []uint64{1, 2, 3} {
+			m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: id}, []grpc.CallOption(nil)).Return(&statistico.Team{Id: id}, nil)
+		}
+
+		_, _ = teams.ByID(ctx, 1)
+		_, _ = teams.ByID(ctx, 1)
+		_, _ = teams.ByID(ctx, 2)
+		_, _ = teams.ByID(ctx, 3)
+
+		stats, err := cache.Stats(ctx)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, statisticofootballdata.MethodCacheStats{Hits: 1, Misses: 3, Evictions: 1, Entries: 2}, stats[statisticofootballdata.MethodTeamByID])
+		assert.Equal(t, statisticofootballdata.MethodCacheStats{}, stats[statisticofootballdata.MethodFixtureSearch])
+		assert.Len(t, stats, len(statisticofootballdata.Methods()))
+	})
+
+	t.Run("returns counters alongside an error if the cache cannot list keys", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		cache := statisticofootballdata.NewResponseCache(
+			statisticofootballdata.WithCache(unlistableCache{statisticofootballdata.NewMemoryCache(10)}),
+		)
+		teams := statisticofootballdata.NewCachedTeamClient(statisticofootballdata.NewTeamClient(m), cache)
+
+		ctx := context.Background()
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{Id: 1}, nil)
+
+		_, _ = teams.ByID(ctx, 1)
+
+		stats, err := cache.Stats(ctx)
+
+		assert.Equal(t, statisticofootballdata.ErrCacheNotListable, err)
+		assert.Equal(t, uint64(1), stats[statisticofootballdata.MethodTeamByID].Misses)
+	})
+}
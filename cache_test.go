@@ -0,0 +1,448 @@
+package statisticofootballdata_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"io"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestResponseCache(t *testing.T) {
+	t.Run("serves repeated requests from the cache", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewCachedTeamClient(
+			statisticofootballdata.NewTeamClient(m),
+			statisticofootballdata.NewResponseCache(),
+		)
+
+		ctx := context.Background()
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).
+			Return(&statistico.Team{Id: 1, Name: "West Ham United"}, nil)
+
+		for i := 0; i < 3; i++ {
+			team, err := client.ByID(ctx, 1)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, "West Ham United", team.GetName())
+		}
+
+		m.AssertNumberOfCalls(t, "GetTeamByID", 1)
+	})
+
+	t.Run("returns copies so callers cannot modify cached values", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewCachedTeamClient(
+			statisticofootballdata.NewTeamClient(m),
+			statisticofootballdata.NewResponseCache(),
+		)
+
+		ctx := context.Background()
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).
+			Return(&statistico.Team{Id: 1, Name: "West Ham United"}, nil)
+
+		first, _ := client.ByID(ctx, 1)
+		first.Name = "Changed"
+
+		second, _ := client.ByID(ctx, 1)
+
+		assert.Equal(t, "West Ham United", second.GetName())
+	})
+
+	t.Run("caches not found errors", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewCachedTeamClient(
+			statisticofootballdata.NewTeamClient(m),
+			statisticofootballdata.NewResponseCache(),
+		)
+
+		ctx := context.Background()
+
+		e := status.Error(codes.NotFound, "not found")
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{}, e)
+
+		for i := 0; i < 2; i++ {
+			_, err := client.ByID(ctx, 1)
+
+			if err == nil {
+				t.Fatal("Expected errors, got nil")
+			}
+
+			assert.IsType(t, statisticofootballdata.ErrorNotFound{}, err)
+			assert.Equal(t, "resource with ID '1' does not exist. Error: rpc error: code = NotFound desc = not found", err.Error())
+		}
+
+		m.AssertNumberOfCalls(t, "GetTeamByID", 1)
+	})
+
+	t.Run("does not cache not found errors if negative caching is disabled", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewCachedTeamClient(
+			statisticofootballdata.NewTeamClient(m),
+			statisticofootballdata.NewResponseCache(statisticofootballdata.WithNotFoundTTL(0)),
+		)
+
+		ctx := context.Background()
+
+		e := status.Error(codes.NotFound, "not found")
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{}, e)
+
+		_, _ = client.ByID(ctx, 1)
+		_, _ = client.ByID(ctx, 1)
+
+		m.AssertNumberOfCalls(t, "GetTeamByID", 2)
+	})
+
+	t.Run("does not cache other errors", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewCachedTeamClient(
+			statisticofootballdata.NewTeamClient(m),
+			statisticofootballdata.NewResponseCache(),
+		)
+
+		ctx := context.Background()
+
+		e := status.Error(codes.Unavailable, "unavailable")
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{}, e)
+
+		_, _ = client.ByID(ctx, 1)
+		_, err := client.ByID(ctx, 1)
+
+		assert.Equal(t, "error connecting to the data service: rpc error: code = Unavailable desc = unavailable", err.Error())
+		m.AssertNumberOfCalls(t, "GetTeamByID", 2)
+	})
+
+	t.Run("expires entries once the method ttl has passed", func(t *testing.T) {
+		t.Helper()
+
+		clock := newTestClock()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewCachedTeamClient(
+			statisticofootballdata.NewTeamClient(m),
+			statisticofootballdata.NewResponseCache(
+				statisticofootballdata.WithMethodTTL(statisticofootballdata.MethodTeamByID, 10*time.Millisecond),
+				statisticofootballdata.WithClock(clock.Now),
+			),
+		)
+
+		ctx := context.Background()
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{Id: 1}, nil)
+
+		_, _ = client.ByID(ctx, 1)
+		clock.Advance(20 * time.Millisecond)
+		_, _ = client.ByID(ctx, 1)
+
+		m.AssertNumberOfCalls(t, "GetTeamByID", 2)
+	})
+
+	t.Run("does not cache methods with a ttl of zero", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewCachedTeamClient(
+			statisticofootballdata.NewTeamClient(m),
+			statisticofootballdata.NewResponseCache(
+				statisticofootballdata.WithMethodTTL(statisticofootballdata.MethodTeamByID, 0),
+			),
+		)
+
+		ctx := context.Background()
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{Id: 1}, nil)
+
+		_, _ = client.ByID(ctx, 1)
+		_, _ = client.ByID(ctx, 1)
+
+		m.AssertNumberOfCalls(t, "GetTeamByID", 2)
+	})
+
+	t.Run("evicts the least recently used entry once full", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewCachedTeamClient(
+			statisticofootballdata.NewTeamClient(m),
+			statisticofootballdata.NewResponseCache(statisticofootballdata.WithCacheSize(2)),
+		)
+
+		ctx := context.Background()
+
+		for _, id := range []uint64{1, 2, 3} {
+			m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: id}, []grpc.CallOption(nil)).Return(&statistico.Team{Id: id}, nil)
+		}
+
+		_, _ = client.ByID(ctx, 1)
+		_, _ = client.ByID(ctx, 2)
+		_, _ = client.ByID(ctx, 1)
+		_, _ = client.ByID(ctx, 3)
+		_, _ = client.ByID(ctx, 1)
+		_, _ = client.ByID(ctx, 2)
+
+		m.AssertNumberOfCalls(t, "GetTeamByID", 4)
+	})
+
+	t.Run("keys fixture searches by the full request message", func(t *testing.T) {
+		t.Helper()
+
+		pc := new(MockFixtureProtoClient)
+		client := statisticofootballdata.NewCachedFixtureClient(
+			statisticofootballdata.NewFixtureClient(pc),
+			statisticofootballdata.NewResponseCache(),
+		)
+
+		ctx := context.Background()
+
+		first := statistico.FixtureSearchRequest{SeasonIds: []uint64{16036}, Limit: &wrapperspb.UInt64Value{Value: 5}}
+		second := statistico.FixtureSearchRequest{SeasonIds: []uint64{16036}, Limit: &wrapperspb.UInt64Value{Value: 10}}
+
+		for _, req := range []*statistico.FixtureSearchRequest{&first, &second} {
+			stream := new(MockFixtureStream)
+			stream.On("Recv").Once().Return(&statistico.Fixture{Id: 1}, nil)
+			stream.On("Recv").Once().Return(&statistico.Fixture{}, io.EOF)
+
+			pc.On("Search", ctx, req, []grpc.CallOption(nil)).Once().Return(stream, nil)
+		}
+
+		for i := 0; i < 2; i++ {
+			a, err := client.Search(ctx, &statistico.FixtureSearchRequest{SeasonIds: []uint64{16036}, Limit: &wrapperspb.UInt64Value{Value: 5}})
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			b, err := client.Search(ctx, &second)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, 1, len(a))
+			assert.Equal(t, 1, len(b))
+		}
+
+		pc.AssertNumberOfCalls(t, "Search", 2)
+	})
+}
+
+func TestResponseCache_StaleWhileRevalidate(t *testing.T) {
+	t.Run("serves stale entries and refreshes them in the background", func(t *testing.T) {
+		t.Helper()
+
+		clock := newTestClock()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewCachedTeamClient(
+			statisticofootballdata.NewTeamClient(m),
+			statisticofootballdata.NewResponseCache(
+				statisticofootballdata.WithMethodTTL(statisticofootballdata.MethodTeamByID, 10*time.Millisecond),
+				statisticofootballdata.WithStaleWhileRevalidate(time.Minute),
+				statisticofootballdata.WithClock(clock.Now),
+			),
+		)
+
+		req := statistico.TeamRequest{TeamId: 1}
+
+		m.On("GetTeamByID", mock.Anything, &req, []grpc.CallOption(nil)).Once().Return(&statistico.Team{Id: 1, Name: "Old"}, nil)
+		m.On("GetTeamByID", mock.Anything, &req, []grpc.CallOption(nil)).Once().Return(&statistico.Team{Id: 1, Name: "New"}, nil)
+
+		_, _ = client.ByID(context.Background(), 1)
+		clock.Advance(20 * time.Millisecond)
+
+		ctx, info := statisticofootballdata.WithResponseInfo(context.Background())
+
+		team, err := client.ByID(ctx, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "Old", team.GetName())
+		assert.True(t, info.Cached)
+		assert.True(t, info.Stale)
+
+		assert.Eventually(t, func() bool {
+			ctx, info := statisticofootballdata.WithResponseInfo(context.Background())
+
+			team, _ := client.ByID(ctx, 1)
+
+			return team.GetName() == "New" && info.Cached && !info.Stale
+		}, time.Second, 5*time.Millisecond)
+
+		m.AssertNumberOfCalls(t, "GetTeamByID", 2)
+	})
+
+	t.Run("calls through once entries are older than the stale window", func(t *testing.T) {
+		t.Helper()
+
+		clock := newTestClock()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewCachedTeamClient(
+			statisticofootballdata.NewTeamClient(m),
+			statisticofootballdata.NewResponseCache(
+				statisticofootballdata.WithMethodTTL(statisticofootballdata.MethodTeamByID, 5*time.Millisecond),
+				statisticofootballdata.WithStaleWhileRevalidate(5*time.Millisecond),
+				statisticofootballdata.WithClock(clock.Now),
+			),
+		)
+
+		ctx := context.Background()
+
+		m.On("GetTeamByID", mock.Anything, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{Id: 1}, nil)
+
+		_, _ = client.ByID(ctx, 1)
+		clock.Advance(20 * time.Millisecond)
+
+		ctx, info := statisticofootballdata.WithResponseInfo(ctx)
+
+		_, _ = client.ByID(ctx, 1)
+
+		assert.False(t, info.Cached)
+		m.AssertNumberOfCalls(t, "GetTeamByID", 2)
+	})
+}
+
+func TestResponseCache_StaleIfError(t *testing.T) {
+	for _, code := range []codes.Code{codes.Unavailable, codes.Internal} {
+		t.Run("serves stale entries if the data service fails with "+code.String(), func(t *testing.T) {
+			t.Helper()
+
+			clock := newTestClock()
+
+			m := new(MockProtoSeasonClient)
+			client := statisticofootballdata.NewCachedSeasonClient(
+				statisticofootballdata.NewSeasonClient(m),
+				statisticofootballdata.NewResponseCache(
+					statisticofootballdata.WithCacheTTL(10*time.Millisecond),
+					statisticofootballdata.WithStaleIfError(time.Hour),
+					statisticofootballdata.WithClock(clock.Now),
+				),
+			)
+
+			ctx := context.Background()
+			req := statistico.SeasonCompetitionRequest{CompetitionId: 8, Sort: &wrapperspb.StringValue{Value: "name_desc"}}
+
+			ok := new(MockSeasonStream)
+			ok.On("Recv").Once().Return(newProtoSeason(), nil)
+			ok.On("Recv").Once().Return(&statistico.Season{}, io.EOF)
+
+			m.On("GetSeasonsForCompetition", ctx, &req, []grpc.CallOption(nil)).Once().Return(ok, nil)
+			m.On("GetSeasonsForCompetition", mock.Anything, &req, []grpc.CallOption(nil)).Once().Return(new(MockSeasonStream), status.Error(code, "oh no"))
+
+			_, _ = client.ByCompetitionID(ctx, 8, "name_desc")
+			clock.Advance(20 * time.Millisecond)
+
+			ctx, info := statisticofootballdata.WithResponseInfo(ctx)
+
+			seasons, err := client.ByCompetitionID(ctx, 8, "name_desc")
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, 1, len(seasons))
+			assert.True(t, info.Cached)
+			assert.True(t, info.Stale)
+			m.AssertNumberOfCalls(t, "GetSeasonsForCompetition", 2)
+		})
+	}
+
+	t.Run("returns errors other than bad gateway and external server errors", func(t *testing.T) {
+		t.Helper()
+
+		clock := newTestClock()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewCachedTeamClient(
+			statisticofootballdata.NewTeamClient(m),
+			statisticofootballdata.NewResponseCache(
+				statisticofootballdata.WithCacheTTL(10*time.Millisecond),
+				statisticofootballdata.WithStaleIfError(time.Hour),
+				statisticofootballdata.WithClock(clock.Now),
+			),
+		)
+
+		ctx := context.Background()
+		req := statistico.TeamRequest{TeamId: 1}
+
+		m.On("GetTeamByID", ctx, &req, []grpc.CallOption(nil)).Once().Return(&statistico.Team{Id: 1}, nil)
+		m.On("GetTeamByID", ctx, &req, []grpc.CallOption(nil)).Once().Return(&statistico.Team{}, status.Error(codes.NotFound, "not found"))
+
+		_, _ = client.ByID(ctx, 1)
+		clock.Advance(20 * time.Millisecond)
+
+		_, err := client.ByID(ctx, 1)
+
+		assert.IsType(t, statisticofootballdata.ErrorNotFound{}, err)
+	})
+
+	t.Run("returns the error if no stale entry is available", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewCachedTeamClient(
+			statisticofootballdata.NewTeamClient(m),
+			statisticofootballdata.NewResponseCache(statisticofootballdata.WithStaleIfError(time.Hour)),
+		)
+
+		ctx := context.Background()
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{}, status.Error(codes.Unavailable, "unavailable"))
+
+		_, err := client.ByID(ctx, 1)
+
+		assert.IsType(t, statisticofootballdata.ErrorBadGateway{}, err)
+	})
+}
+
+// testClock is a clock tests advance by hand, so cache entries can be expired without sleeping.
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func newTestClock() *testClock {
+	return &testClock{now: time.Date(2019, time.September, 14, 15, 0, 0, 0, time.UTC)}
+}
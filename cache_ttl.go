@@ -0,0 +1,120 @@
+package statisticofootballdata
+
+import (
+	"context"
+	statistico "github.com/statistico/statistico-proto/go"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+// TTLPolicy decides how long a response is cached for based on its content. Returning false defers
+// to the TTLs configured with WithCacheTTL and WithMethodTTL, and a TTL of zero or less skips caching.
+type TTLPolicy interface {
+	TTL(ctx context.Context, method string, req proto.Message, res []proto.Message) (time.Duration, bool)
+}
+
+// TTLPolicyFunc adapts a function to the TTLPolicy interface.
+type TTLPolicyFunc func(ctx context.Context, method string, req proto.Message, res []proto.Message) (time.Duration, bool)
+
+func (f TTLPolicyFunc) TTL(ctx context.Context, method string, req proto.Message, res []proto.Message) (time.Duration, bool) {
+	return f(ctx, method, req, res)
+}
+
+// FixtureTTLPolicy caches fixture data according to the status of the fixture it belongs to. Data
+// for completed fixtures never changes so is cached effectively forever, whereas upcoming and in play
+// fixtures are cached for minutes and seconds respectively. Fixtures without a kick-off time are
+// cached as in play. Methods not returning fixture data are left to the cache's method TTLs.
+type FixtureTTLPolicy struct {
+	// Fixtures resolves the fixture behind FixtureEvents, TeamStats and PlayerStats requests as their
+	// responses do not carry a kick-off time. Wrapping it in a caching decorator keeps lookups cheap.
+	Fixtures  FixtureClient
+	Completed time.Duration
+	Upcoming  time.Duration
+	InPlay    time.Duration
+	// FullTime is how long after kick-off a fixture is treated as in play, defaulting to DefaultFullTime.
+	FullTime time.Duration
+
+	clock func() time.Time
+}
+
+func (p *FixtureTTLPolicy) TTL(ctx context.Context, method string, req proto.Message, res []proto.Message) (time.Duration, bool) {
+	switch method {
+	case MethodFixtureByID, MethodFixtureSearch:
+		if len(res) == 0 {
+			return p.Upcoming, true
+		}
+
+		var ttl time.Duration
+
+		for i, m := range res {
+			f, ok := m.(*statistico.Fixture)
+
+			if !ok {
+				return 0, false
+			}
+
+			if t := p.ttlFor(f); i == 0 || t < ttl {
+				ttl = t
+			}
+		}
+
+		return ttl, true
+	case MethodEventFixtureEvents, MethodPlayerStatsFixtureStats, MethodTeamStatStats:
+		r, ok := req.(*statistico.FixtureRequest)
+
+		if !ok || p.Fixtures == nil {
+			return p.InPlay, true
+		}
+
+		// The lookup describes the policy rather than the call being cached, so it must not populate
+		// the caller's ResponseInfo.
+		ctx = context.WithValue(ctx, responseInfoKey{}, (*ResponseInfo)(nil))
+
+		f, err := p.Fixtures.ByID(ctx, r.GetFixtureId())
+
+		if err != nil || f == nil {
+			return p.InPlay, true
+		}
+
+		return p.ttlFor(f), true
+	}
+
+	return 0, false
+}
+
+func (p *FixtureTTLPolicy) ttlFor(f *statistico.Fixture) time.Duration {
+	if f.GetDateTime() == nil {
+		return p.InPlay
+	}
+
+	now, fullTime := time.Now, p.FullTime
+
+	if p.clock != nil {
+		now = p.clock
+	}
+
+	if fullTime == 0 {
+		fullTime = DefaultFullTime
+	}
+
+	switch StatusOf(f, now(), fullTime) {
+	case FixtureStatusCompleted:
+		return p.Completed
+	case FixtureStatusInPlay:
+		return p.InPlay
+	default:
+		return p.Upcoming
+	}
+}
+
+// NewFixtureTTLPolicy creates a FixtureTTLPolicy caching completed fixtures for a year, upcoming
+// fixtures for five minutes and in play fixtures for fifteen seconds.
+func NewFixtureTTLPolicy(fixtures FixtureClient) *FixtureTTLPolicy {
+	return &FixtureTTLPolicy{
+		Fixtures:  fixtures,
+		Completed: 365 * 24 * time.Hour,
+		Upcoming:  5 * time.Minute,
+		InPlay:    15 * time.Second,
+		FullTime:  DefaultFullTime,
+	}
+}
@@ -0,0 +1,229 @@
+package statisticofootballdata_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"testing"
+	"time"
+)
+
+func fixtureKickingOffAt(id int64, t time.Time) *statistico.Fixture {
+	return &statistico.Fixture{Id: id, DateTime: &statistico.Date{Utc: t.Unix()}}
+}
+
+func TestFixtureTTLPolicy_TTL(t *testing.T) {
+	completed := fixtureKickingOffAt(1, time.Now().Add(-48*time.Hour))
+	inPlay := fixtureKickingOffAt(2, time.Now().Add(-time.Hour))
+	upcoming := fixtureKickingOffAt(3, time.Now().Add(48*time.Hour))
+
+	t.Run("returns a ttl based on the status of a fixture", func(t *testing.T) {
+		t.Helper()
+
+		policy := statisticofootballdata.NewFixtureTTLPolicy(nil)
+		ctx := context.Background()
+
+		tests := []struct {
+			fixture *statistico.Fixture
+			ttl     time.Duration
+		}{
+			{completed, policy.Completed},
+			{inPlay, policy.InPlay},
+			{upcoming, policy.Upcoming},
+		}
+
+		for _, tc := range tests {
+			ttl, ok := policy.TTL(ctx, statisticofootballdata.MethodFixtureByID, &statistico.FixtureRequest{}, []proto.Message{tc.fixture})
+
+			assert.True(t, ok)
+			assert.Equal(t, tc.ttl, ttl)
+		}
+	})
+
+	t.Run("uses the shortest ttl of the fixtures returned by a search", func(t *testing.T) {
+		t.Helper()
+
+		policy := statisticofootballdata.NewFixtureTTLPolicy(nil)
+
+		ttl, ok := policy.TTL(
+			context.Background(),
+			statisticofootballdata.MethodFixtureSearch,
+			&statistico.FixtureSearchRequest{},
+			[]proto.Message{completed, upcoming, inPlay},
+		)
+
+		assert.True(t, ok)
+		assert.Equal(t, policy.InPlay, ttl)
+	})
+
+	t.Run("looks up the fixture for fixture events and stats", func(t *testing.T) {
+		t.Helper()
+
+		pc := new(MockFixtureProtoClient)
+		policy := statisticofootballdata.NewFixtureTTLPolicy(statisticofootballdata.NewFixtureClient(pc))
+		ctx := context.Background()
+		req := statistico.FixtureRequest{FixtureId: 1}
+
+		pc.On("FixtureByID", mock.Anything, &req, []grpc.CallOption(nil)).Return(completed, nil)
+
+		for _, method := range []string{
+			statisticofootballdata.MethodEventFixtureEvents,
+			statisticofootballdata.MethodPlayerStatsFixtureStats,
+			statisticofootballdata.MethodTeamStatStats,
+		} {
+			ttl, ok := policy.TTL(ctx, method, &req, []proto.Message{&statistico.TeamStatsResponse{}})
+
+			assert.True(t, ok)
+			assert.Equal(t, policy.Completed, ttl)
+		}
+	})
+
+	t.Run("uses the in play ttl if the fixture cannot be resolved", func(t *testing.T) {
+		t.Helper()
+
+		pc := new(MockFixtureProtoClient)
+		policy := statisticofootballdata.NewFixtureTTLPolicy(statisticofootballdata.NewFixtureClient(pc))
+		ctx := context.Background()
+		req := statistico.FixtureRequest{FixtureId: 1}
+
+		pc.On("FixtureByID", mock.Anything, &req, []grpc.CallOption(nil)).Return(&statistico.Fixture{}, status.Error(codes.Unavailable, "unavailable"))
+
+		ttl, ok := policy.TTL(ctx, statisticofootballdata.MethodTeamStatStats, &req, []proto.Message{&statistico.TeamStatsResponse{}})
+
+		assert.True(t, ok)
+		assert.Equal(t, policy.InPlay, ttl)
+	})
+
+	t.Run("uses the in play ttl for fixtures without a kick-off time", func(t *testing.T) {
+		t.Helper()
+
+		policy := statisticofootballdata.NewFixtureTTLPolicy(nil)
+
+		ttl, ok := policy.TTL(context.Background(), statisticofootballdata.MethodFixtureByID, &statistico.FixtureRequest{}, []proto.Message{&statistico.Fixture{Id: 2}})
+
+		assert.True(t, ok)
+		assert.Equal(t, policy.InPlay, ttl)
+	})
+
+	t.Run("uses the default full time if none is set", func(t *testing.T) {
+		t.Helper()
+
+		policy := &statisticofootballdata.FixtureTTLPolicy{Completed: time.Hour, InPlay: time.Second}
+
+		ttl, ok := policy.TTL(context.Background(), statisticofootballdata.MethodFixtureByID, &statistico.FixtureRequest{}, []proto.Message{inPlay})
+
+		assert.True(t, ok)
+		assert.Equal(t, policy.InPlay, ttl)
+	})
+
+	t.Run("defers to method ttls for other methods", func(t *testing.T) {
+		t.Helper()
+
+		policy := statisticofootballdata.NewFixtureTTLPolicy(nil)
+
+		_, ok := policy.TTL(context.Background(), statisticofootballdata.MethodTeamByID, &statistico.TeamRequest{}, []proto.Message{&statistico.Team{}})
+
+		assert.False(t, ok)
+	})
+}
+
+func TestResponseCache_WithTTLPolicy(t *testing.T) {
+	t.Run("caches completed fixtures and refreshes in play fixtures", func(t *testing.T) {
+		t.Helper()
+
+		policy := statisticofootballdata.NewFixtureTTLPolicy(nil)
+		policy.InPlay = 10 * time.Millisecond
+
+		clock := newTestClock()
+
+		pc := new(MockFixtureProtoClient)
+		client := statisticofootballdata.NewCachedFixtureClient(
+			statisticofootballdata.NewFixtureClient(pc),
+			statisticofootballdata.NewResponseCache(
+				statisticofootballdata.WithTTLPolicy(policy),
+				statisticofootballdata.WithClock(clock.Now),
+			),
+		)
+
+		ctx := context.Background()
+
+		pc.On("FixtureByID", ctx, &statistico.FixtureRequest{FixtureId: 1}, []grpc.CallOption(nil)).
+			Return(fixtureKickingOffAt(1, clock.Now().Add(-48*time.Hour)), nil)
+		pc.On("FixtureByID", ctx, &statistico.FixtureRequest{FixtureId: 2}, []grpc.CallOption(nil)).
+			Return(fixtureKickingOffAt(2, clock.Now().Add(-time.Hour)), nil)
+
+		_, _ = client.ByID(ctx, 1)
+		_, _ = client.ByID(ctx, 2)
+		clock.Advance(20 * time.Millisecond)
+		_, _ = client.ByID(ctx, 1)
+		_, _ = client.ByID(ctx, 2)
+
+		pc.AssertNumberOfCalls(t, "FixtureByID", 3)
+	})
+
+	t.Run("derives fixture statuses using the cache clock", func(t *testing.T) {
+		t.Helper()
+
+		policy := statisticofootballdata.NewFixtureTTLPolicy(nil)
+
+		clock := newTestClock()
+
+		pc := new(MockFixtureProtoClient)
+		client := statisticofootballdata.NewCachedFixtureClient(
+			statisticofootballdata.NewFixtureClient(pc),
+			statisticofootballdata.NewResponseCache(
+				statisticofootballdata.WithTTLPolicy(policy),
+				statisticofootballdata.WithClock(clock.Now),
+			),
+		)
+
+		ctx := context.Background()
+
+		pc.On("FixtureByID", ctx, &statistico.FixtureRequest{FixtureId: 1}, []grpc.CallOption(nil)).
+			Return(fixtureKickingOffAt(1, clock.Now().Add(time.Hour)), nil)
+
+		_, _ = client.ByID(ctx, 1)
+		clock.Advance(policy.Upcoming - time.Second)
+		_, _ = client.ByID(ctx, 1)
+		clock.Advance(2 * time.Second)
+		_, _ = client.ByID(ctx, 1)
+
+		pc.AssertNumberOfCalls(t, "FixtureByID", 2)
+	})
+
+	t.Run("does not populate the response info of a call with the fixture lookup", func(t *testing.T) {
+		t.Helper()
+
+		policy := statisticofootballdata.NewFixtureTTLPolicy(nil)
+		cache := statisticofootballdata.NewResponseCache(statisticofootballdata.WithTTLPolicy(policy))
+
+		pc := new(MockFixtureProtoClient)
+		policy.Fixtures = statisticofootballdata.NewCachedFixtureClient(statisticofootballdata.NewFixtureClient(pc), cache)
+
+		m := new(MockProtoEventClient)
+		client := statisticofootballdata.NewCachedEventClient(statisticofootballdata.NewEventClient(m), cache)
+
+		pc.On("FixtureByID", mock.Anything, &statistico.FixtureRequest{FixtureId: 192}, []grpc.CallOption(nil)).
+			Return(fixtureKickingOffAt(192, time.Now().Add(-48*time.Hour)), nil)
+		m.On("FixtureEvents", mock.Anything, &statistico.FixtureRequest{FixtureId: 192}, []grpc.CallOption(nil)).
+			Return(&statistico.FixtureEventsResponse{FixtureId: 192}, nil)
+
+		if _, err := policy.Fixtures.ByID(context.Background(), 192); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		ctx, info := statisticofootballdata.WithResponseInfo(context.Background())
+
+		if _, err := client.FixtureEvents(ctx, 192); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, statisticofootballdata.ResponseInfo{}, *info)
+	})
+}
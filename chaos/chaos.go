@@ -0,0 +1,234 @@
+// Package chaos injects faults into the calls made by the data service clients, for testing how
+// applications behave when the data service is slow, failing or cutting streams short.
+package chaos
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// AllMethods applies a Fault to every method.
+const AllMethods = ""
+
+type kind int
+
+const (
+	latency kind = iota
+	failure
+	truncate
+	failRecv
+)
+
+// Fault is a misbehaviour injected into calls to a method, identified by its full gRPC method name such
+// as statistico.FixtureService_Search_FullMethodName, or every method using AllMethods. Faults apply to
+// every call unless given a rate using WithRate.
+type Fault struct {
+	kind    kind
+	method  string
+	rate    float64
+	latency time.Duration
+	code    codes.Code
+	n       int
+}
+
+// Latency delays calls by d before they are made.
+func Latency(method string, d time.Duration) Fault {
+	return Fault{kind: latency, method: method, rate: 1, latency: d}
+}
+
+// Error fails calls with code before they are made.
+func Error(method string, code codes.Code) Fault {
+	return Fault{kind: failure, method: method, rate: 1, code: code}
+}
+
+// Truncate ends server streaming calls cleanly after n items, as if the data service had no more.
+func Truncate(method string, n int) Fault {
+	return Fault{kind: truncate, method: method, rate: 1, n: n}
+}
+
+// FailRecv fails server streaming calls with code after n items have been received.
+func FailRecv(method string, n int, code codes.Code) Fault {
+	return Fault{kind: failRecv, method: method, rate: 1, n: n, code: code}
+}
+
+// WithRate returns a copy of the Fault applying to calls with a probability between 0 and 1.
+func (f Fault) WithRate(rate float64) Fault {
+	f.rate = rate
+	return f
+}
+
+// Injector injects Faults into calls. Whether faults with a rate apply is decided using a random source
+// seeded on creation, so calls made in the same order see the same faults on every run.
+type Injector struct {
+	faults []Fault
+	mu     sync.Mutex
+	rng    *rand.Rand
+}
+
+// New creates an Injector injecting faults, seeding its random source with seed.
+func New(seed int64, faults ...Fault) *Injector {
+	return &Injector{faults: faults, rng: rand.New(rand.NewSource(seed))}
+}
+
+// DialOptions returns the options installing the Injector into a connection, for use with
+// statisticofootballdata.WithGRPCDialOptions.
+func (i *Injector) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(i.UnaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(i.StreamClientInterceptor),
+	}
+}
+
+// Wrap returns a connection injecting faults into calls made over conn, such as a connection created by
+// the statisticofootballdatatest package, for use with statisticofootballdata.NewClient.
+func (i *Injector) Wrap(conn grpc.ClientConnInterface) grpc.ClientConnInterface {
+	return &faultyConn{conn: conn, injector: i}
+}
+
+// UnaryClientInterceptor injects faults into unary calls.
+func (i *Injector) UnaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if _, err := i.before(ctx, method); err != nil {
+		return err
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// StreamClientInterceptor injects faults into streaming calls.
+func (i *Injector) StreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return i.stream(ctx, method, func(ctx context.Context) (grpc.ClientStream, error) {
+		return streamer(ctx, desc, cc, method, opts...)
+	})
+}
+
+// before applies latency and failures to a call to method, returning the stream faults applying to it.
+func (i *Injector) before(ctx context.Context, method string) ([]Fault, error) {
+	var (
+		delay  time.Duration
+		err    error
+		stream []Fault
+	)
+
+	i.mu.Lock()
+
+	for _, f := range i.faults {
+		if f.method != AllMethods && f.method != method {
+			continue
+		}
+
+		if f.rate < 1 && i.rng.Float64() >= f.rate {
+			continue
+		}
+
+		switch f.kind {
+		case latency:
+			delay += f.latency
+		case failure:
+			if err == nil {
+				err = status.Errorf(f.code, "injected %s fault", f.code)
+			}
+		default:
+			stream = append(stream, f)
+		}
+	}
+
+	i.mu.Unlock()
+
+	if delay > 0 {
+		t := time.NewTimer(delay)
+		defer t.Stop()
+
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+
+	return stream, err
+}
+
+func (i *Injector) stream(ctx context.Context, method string, open func(context.Context) (grpc.ClientStream, error)) (grpc.ClientStream, error) {
+	faults, err := i.before(ctx, method)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(faults) == 0 {
+		return open(ctx)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := open(ctx)
+
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	return &faultyStream{ClientStream: stream, faults: faults, cancel: cancel}, nil
+}
+
+// faultyStream truncates or fails a stream once a number of items have been received, cancelling the
+// underlying stream. If several faults apply, the first to be reached ends the stream, with ties going
+// to the fault configured first.
+type faultyStream struct {
+	grpc.ClientStream
+	faults   []Fault
+	cancel   context.CancelFunc
+	received int
+}
+
+func (s *faultyStream) RecvMsg(m any) error {
+	for _, f := range s.faults {
+		if s.received < f.n {
+			continue
+		}
+
+		s.cancel()
+
+		if f.kind == truncate {
+			return io.EOF
+		}
+
+		return status.Errorf(f.code, "injected %s fault after %d items", f.code, f.n)
+	}
+
+	err := s.ClientStream.RecvMsg(m)
+
+	if err != nil {
+		s.cancel()
+		return err
+	}
+
+	s.received++
+
+	return nil
+}
+
+type faultyConn struct {
+	conn     grpc.ClientConnInterface
+	injector *Injector
+}
+
+func (c *faultyConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	if _, err := c.injector.before(ctx, method); err != nil {
+		return err
+	}
+
+	return c.conn.Invoke(ctx, method, args, reply, opts...)
+}
+
+func (c *faultyConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return c.injector.stream(ctx, method, func(ctx context.Context) (grpc.ClientStream, error) {
+		return c.conn.NewStream(ctx, desc, method, opts...)
+	})
+}
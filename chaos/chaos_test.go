@@ -0,0 +1,191 @@
+package chaos_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-football-data-go-grpc-client/chaos"
+	"github.com/statistico/statistico-football-data-go-grpc-client/statisticofootballdatatest"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"testing"
+	"time"
+)
+
+func TestInjector(t *testing.T) {
+	t.Run("fails calls to a method with a code", func(t *testing.T) {
+		t.Helper()
+
+		client := newClient(t, chaos.New(1, chaos.Error(statistico.TeamService_GetTeamByID_FullMethodName, codes.Internal)))
+
+		_, err := client.Teams.ByID(context.Background(), 1)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.IsType(t, statisticofootballdata.ErrorBadGateway{}, err)
+		assert.Contains(t, err.Error(), "injected Internal fault")
+
+		_, err = client.Fixtures.ByID(context.Background(), 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+	})
+
+	t.Run("delays calls and respects the deadline", func(t *testing.T) {
+		t.Helper()
+
+		client := newClient(t, chaos.New(1, chaos.Latency(chaos.AllMethods, 50*time.Millisecond)))
+
+		start := time.Now()
+
+		_, err := client.Teams.BySeasonID(context.Background(), 16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err = client.Teams.ByID(ctx, 1)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Contains(t, err.Error(), "DeadlineExceeded")
+	})
+
+	t.Run("truncates streams after a number of items", func(t *testing.T) {
+		t.Helper()
+
+		client := newClient(t, chaos.New(1, chaos.Truncate(statistico.TeamService_GetTeamsBySeasonId_FullMethodName, 1)))
+
+		teams, err := client.Teams.BySeasonID(context.Background(), 16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(teams))
+		assert.Equal(t, uint64(1), teams[0].GetId())
+	})
+
+	t.Run("fails streams mid stream", func(t *testing.T) {
+		t.Helper()
+
+		client := newClient(t, chaos.New(1, chaos.FailRecv(statistico.TeamService_GetTeamsBySeasonId_FullMethodName, 2, codes.Unavailable)))
+
+		teams, err := client.Teams.BySeasonID(context.Background(), 16036)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, 2, len(teams))
+		assert.IsType(t, statisticofootballdata.ErrorExternalServer{}, err)
+		assert.Contains(t, err.Error(), "injected Unavailable fault after 2 items")
+	})
+
+	t.Run("ends streams at the first of several stream faults reached", func(t *testing.T) {
+		t.Helper()
+
+		client := newClient(t, chaos.New(
+			1,
+			chaos.Truncate(statistico.TeamService_GetTeamsBySeasonId_FullMethodName, 2),
+			chaos.FailRecv(statistico.TeamService_GetTeamsBySeasonId_FullMethodName, 1, codes.Unavailable),
+		))
+
+		teams, err := client.Teams.BySeasonID(context.Background(), 16036)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, 1, len(teams))
+		assert.Contains(t, err.Error(), "injected Unavailable fault after 1 items")
+	})
+
+	t.Run("applies faults at a rate deterministically for a seed", func(t *testing.T) {
+		t.Helper()
+
+		failures := func(seed int64) []bool {
+			client := newClient(t, chaos.New(seed, chaos.Error(chaos.AllMethods, codes.Unavailable).WithRate(0.5)))
+
+			var res []bool
+
+			for i := 0; i < 40; i++ {
+				_, err := client.Teams.ByID(context.Background(), 1)
+				res = append(res, err != nil)
+			}
+
+			return res
+		}
+
+		first := failures(42)
+
+		assert.Equal(t, first, failures(42))
+		assert.NotEqual(t, first, failures(7))
+		assert.Contains(t, first, true)
+		assert.Contains(t, first, false)
+	})
+
+	t.Run("installs into connections using dial options", func(t *testing.T) {
+		t.Helper()
+
+		srv := statisticofootballdatatest.NewServer(dataset())
+		defer srv.Close()
+
+		inj := chaos.New(1, chaos.Error(chaos.AllMethods, codes.NotFound))
+
+		client, err := statisticofootballdata.Dial(
+			statisticofootballdatatest.Target,
+			statisticofootballdata.WithGRPCDialOptions(append(srv.DialOptions(), inj.DialOptions()...)...),
+		)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		_, err = client.Fixtures.ByID(context.Background(), 1)
+
+		assert.IsType(t, statisticofootballdata.ErrorNotFound{}, err)
+
+		teams, err := client.Teams.BySeasonID(context.Background(), 16036)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, 0, len(teams))
+	})
+}
+
+func newClient(t *testing.T, inj *chaos.Injector) *statisticofootballdata.Client {
+	srv := statisticofootballdatatest.NewServer(dataset())
+	t.Cleanup(srv.Close)
+
+	client := statisticofootballdata.NewClient(inj.Wrap(srv.Conn()))
+	t.Cleanup(func() { client.Close() })
+
+	return client
+}
+
+func dataset() *statisticofootballdatatest.Dataset {
+	season := &statistico.Season{Id: 16036}
+
+	return &statisticofootballdatatest.Dataset{
+		Teams: []*statistico.Team{{Id: 1}, {Id: 18}, {Id: 19}},
+		Fixtures: []*statistico.Fixture{
+			{Id: 1, Season: season, HomeTeam: &statistico.Team{Id: 1}, AwayTeam: &statistico.Team{Id: 18}},
+			{Id: 2, Season: season, HomeTeam: &statistico.Team{Id: 19}, AwayTeam: &statistico.Team{Id: 1}},
+		},
+	}
+}
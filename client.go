@@ -0,0 +1,290 @@
+package statisticofootballdata
+
+import (
+	"context"
+	statistico "github.com/statistico/statistico-proto/go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+// Services are the names of the data service gRPC services used by the clients, as reported by the
+// standard gRPC health checking protocol.
+var Services = []string{
+	statistico.CompetitionService_ServiceDesc.ServiceName,
+	statistico.EventService_ServiceDesc.ServiceName,
+	statistico.FixtureService_ServiceDesc.ServiceName,
+	statistico.PlayerService_ServiceDesc.ServiceName,
+	statistico.PlayerStatsService_ServiceDesc.ServiceName,
+	statistico.SeasonService_ServiceDesc.ServiceName,
+	statistico.TeamService_ServiceDesc.ServiceName,
+	statistico.TeamStatsService_ServiceDesc.ServiceName,
+}
+
+// Client provides the clients for every data service over a single connection, along with health
+// checking and connectivity watching for the connection.
+type Client struct {
+	Competitions CompetitionClient
+	Events       EventClient
+	Fixtures     FixtureClient
+	Players      PlayerClient
+	PlayerStats  PlayerStatsClient
+	Seasons      SeasonClient
+	Teams        TeamClient
+	TeamStats    TeamStatClient
+
+	health healthpb.HealthClient
+	close  func() error
+	cancel context.CancelFunc
+
+	mu          sync.Mutex
+	state       connectivity.State
+	subscribers map[chan connectivity.State]struct{}
+}
+
+// HealthReport holds the serving status of each data service.
+type HealthReport map[string]healthpb.HealthCheckResponse_ServingStatus
+
+// Serving reports whether every service is serving.
+func (h HealthReport) Serving() bool {
+	for _, s := range h {
+		if s != healthpb.HealthCheckResponse_SERVING {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Health checks the serving status of each of the Services using the standard gRPC health checking
+// protocol. Services unknown to the health server are reported as SERVICE_UNKNOWN.
+func (c *Client) Health(ctx context.Context) (HealthReport, error) {
+	report := HealthReport{}
+
+	for _, service := range Services {
+		res, err := c.health.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+
+		if err != nil {
+			if e, ok := status.FromError(err); ok {
+				switch e.Code() {
+				case codes.NotFound:
+					report[service] = healthpb.HealthCheckResponse_SERVICE_UNKNOWN
+					continue
+				case codes.Internal:
+					return nil, ErrorExternalServer{err}
+				default:
+					return nil, ErrorBadGateway{err}
+				}
+			}
+
+			return nil, err
+		}
+
+		report[service] = res.GetStatus()
+	}
+
+	return report, nil
+}
+
+// State returns the connectivity state of the connection.
+func (c *Client) State() connectivity.State {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.state
+}
+
+// Ready reports whether the connection is ready to make calls.
+func (c *Client) Ready() bool {
+	return c.State() == connectivity.Ready
+}
+
+// Subscribe returns a channel receiving the connectivity state of the connection, starting with the
+// current state, and a function to stop the subscription. Receivers falling behind only see the latest
+// state. The channel is closed when the subscription is stopped or the Client is closed.
+func (c *Client) Subscribe() (<-chan connectivity.State, func()) {
+	ch := make(chan connectivity.State, 1)
+
+	c.mu.Lock()
+	ch <- c.state
+	c.subscribers[ch] = struct{}{}
+	c.mu.Unlock()
+
+	return ch, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		if _, ok := c.subscribers[ch]; ok {
+			delete(c.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// Close stops watching the connection, closing it if it was created by Dial.
+func (c *Client) Close() error {
+	c.cancel()
+
+	c.mu.Lock()
+	for ch := range c.subscribers {
+		delete(c.subscribers, ch)
+		close(ch)
+	}
+	c.mu.Unlock()
+
+	if c.close != nil {
+		return c.close()
+	}
+
+	return nil
+}
+
+// stateConn is implemented by connections reporting their connectivity state, such as grpc.ClientConn.
+type stateConn interface {
+	GetState() connectivity.State
+	WaitForStateChange(ctx context.Context, state connectivity.State) bool
+	Connect()
+}
+
+func (c *Client) watch(ctx context.Context, conn stateConn) {
+	conn.Connect()
+
+	for {
+		state := conn.GetState()
+
+		c.publish(state)
+
+		if !conn.WaitForStateChange(ctx, state) {
+			return
+		}
+	}
+}
+
+func (c *Client) publish(state connectivity.State) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.state = state
+
+	for ch := range c.subscribers {
+		select {
+		case <-ch:
+		default:
+		}
+
+		ch <- state
+	}
+}
+
+// NewClient creates a Client making calls over conn, with opts applied to every service client. The
+// connectivity state is watched when conn reports it, as grpc.ClientConn does, otherwise the Client is
+// always reported as ready.
+func NewClient(conn grpc.ClientConnInterface, opts ...Option) *Client {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := &Client{
+		Competitions: NewCompetitionClient(statistico.NewCompetitionServiceClient(conn), opts...),
+		Events:       NewEventClient(statistico.NewEventServiceClient(conn), opts...),
+		Fixtures:     NewFixtureClient(statistico.NewFixtureServiceClient(conn), opts...),
+		Players:      NewPlayerClient(statistico.NewPlayerServiceClient(conn), opts...),
+		PlayerStats:  NewPlayerStatsClient(statistico.NewPlayerStatsServiceClient(conn), opts...),
+		Seasons:      NewSeasonClient(statistico.NewSeasonServiceClient(conn), opts...),
+		Teams:        NewTeamClient(statistico.NewTeamServiceClient(conn), opts...),
+		TeamStats:    NewTeamStatClient(statistico.NewTeamStatsServiceClient(conn), opts...),
+		health:       healthpb.NewHealthClient(conn),
+		cancel:       cancel,
+		state:        connectivity.Ready,
+		subscribers:  map[chan connectivity.State]struct{}{},
+	}
+
+	if sc, ok := conn.(stateConn); ok {
+		c.state = sc.GetState()
+		go c.watch(ctx, sc)
+	}
+
+	return c
+}
+
+// DialOption configures the connection created by Dial.
+type DialOption func(o *dialOptions)
+
+type dialOptions struct {
+	grpc                  []grpc.DialOption
+	client                []Option
+	healthCheckInterval   time.Duration
+	failoverCodes         []codes.Code
+	static                []string
+	loadBalancing         string
+	serviceConfig         string
+	poolSize              int
+	compressor            string
+	maxReceiveMessageSize int
+	keepalive             keepalive.ClientParameters
+}
+
+func newDialOptions(opts []DialOption) dialOptions {
+	o := dialOptions{
+		healthCheckInterval:   DefaultHealthCheckInterval,
+		failoverCodes:         []codes.Code{codes.Unavailable},
+		maxReceiveMessageSize: DefaultMaxReceiveMessageSize,
+		keepalive:             DefaultKeepalive,
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// WithGRPCDialOptions adds options used to create the gRPC connection. Connections are insecure unless
+// transport credentials are provided.
+func WithGRPCDialOptions(opts ...grpc.DialOption) DialOption {
+	return func(o *dialOptions) {
+		o.grpc = append(o.grpc, opts...)
+	}
+}
+
+// WithClientOptions adds options applied to every service client.
+func WithClientOptions(opts ...Option) DialOption {
+	return func(o *dialOptions) {
+		o.client = append(o.client, opts...)
+	}
+}
+
+// Dial creates a connection to the data service at target and a Client using it, or a Pool of
+// connections if WithPoolSize is used. The connection is closed when the Client is closed.
+func Dial(target string, opts ...DialOption) (*Client, error) {
+	o := newDialOptions(opts)
+
+	if o.poolSize > 1 {
+		pool, err := NewPool(target, o.poolSize, opts...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		c := NewClient(pool, o.client...)
+		c.close = pool.Close
+
+		return c, nil
+	}
+
+	target, grpcOpts := o.resolve(target)
+
+	conn, err := grpc.NewClient(target, grpcOpts...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c := NewClient(conn, o.client...)
+	c.close = conn.Close
+
+	return c, nil
+}
@@ -0,0 +1,200 @@
+package statisticofootballdata_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestClient_Health(t *testing.T) {
+	t.Run("returns serving status of each service", func(t *testing.T) {
+		t.Helper()
+
+		addr, hs, stop := startHealthServer(t)
+		defer stop()
+
+		for _, s := range statisticofootballdata.Services {
+			hs.SetServingStatus(s, healthpb.HealthCheckResponse_SERVING)
+		}
+
+		hs.SetServingStatus(statistico.TeamService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+
+		client, err := statisticofootballdata.Dial(addr)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		report, err := client.Health(context.Background())
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, len(statisticofootballdata.Services), len(report))
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, report["statistico.FixtureService"])
+		assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, report["statistico.TeamService"])
+		assert.False(t, report.Serving())
+
+		hs.SetServingStatus(statistico.TeamService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+
+		report, err = client.Health(context.Background())
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.True(t, report.Serving())
+	})
+
+	t.Run("reports services unknown to the health server", func(t *testing.T) {
+		t.Helper()
+
+		addr, _, stop := startHealthServer(t)
+		defer stop()
+
+		client, err := statisticofootballdata.Dial(addr)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		report, err := client.Health(context.Background())
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVICE_UNKNOWN, report["statistico.TeamService"])
+		assert.False(t, report.Serving())
+	})
+
+	t.Run("returns bad gateway error if data service is unreachable", func(t *testing.T) {
+		t.Helper()
+
+		addr, _, stop := startHealthServer(t)
+		stop()
+
+		client, err := statisticofootballdata.Dial(addr)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		_, err = client.Health(context.Background())
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.IsType(t, statisticofootballdata.ErrorBadGateway{}, err)
+	})
+}
+
+func TestClient_Ready(t *testing.T) {
+	t.Run("tracks connectivity state changes", func(t *testing.T) {
+		t.Helper()
+
+		addr, _, stop := startHealthServer(t)
+
+		client, err := statisticofootballdata.Dial(addr)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		states, unsubscribe := client.Subscribe()
+		defer unsubscribe()
+
+		assert.Eventually(t, client.Ready, 5*time.Second, 10*time.Millisecond)
+		assert.True(t, receiveState(states, connectivity.Ready))
+
+		stop()
+
+		assert.Eventually(t, func() bool { return !client.Ready() }, 5*time.Second, 10*time.Millisecond)
+		assert.NotEqual(t, connectivity.Ready, client.State())
+	})
+
+	t.Run("closes subscriptions when client is closed", func(t *testing.T) {
+		t.Helper()
+
+		addr, _, stop := startHealthServer(t)
+		defer stop()
+
+		client, err := statisticofootballdata.Dial(addr)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		states, _ := client.Subscribe()
+
+		if err := client.Close(); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		for range states {
+		}
+	})
+
+	t.Run("reports connections without connectivity state as ready", func(t *testing.T) {
+		t.Helper()
+
+		client := statisticofootballdata.NewClient(nilConn{})
+		defer client.Close()
+
+		assert.True(t, client.Ready())
+	})
+}
+
+func startHealthServer(t *testing.T) (string, *health.Server, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("Expected nil, got %s", err.Error())
+	}
+
+	srv := grpc.NewServer()
+	hs := health.NewServer()
+
+	healthpb.RegisterHealthServer(srv, hs)
+
+	go srv.Serve(lis)
+
+	return lis.Addr().String(), hs, srv.Stop
+}
+
+func receiveState(states <-chan connectivity.State, want connectivity.State) bool {
+	timeout := time.After(5 * time.Second)
+
+	for {
+		select {
+		case s := <-states:
+			if s == want {
+				return true
+			}
+		case <-timeout:
+			return false
+		}
+	}
+}
+
+type nilConn struct {
+	grpc.ClientConnInterface
+}
@@ -0,0 +1,57 @@
+// Command statistico-conformance checks a data service behaves as the clients expect, printing the
+// outcome of each check and exiting with status 1 if any fail.
+//
+//	statistico-conformance -target localhost:50051 -country 462 -competition 8 -season 16036 -team 1 -fixture 192 -player 37
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/statistico/statistico-football-data-go-grpc-client/conformance"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"os"
+	"time"
+)
+
+func main() {
+	var cfg conformance.Config
+
+	target := flag.String("target", "localhost:50051", "address of the data service")
+	flag.Uint64Var(&cfg.CountryID, "country", 0, "ID of a country with competitions")
+	flag.Uint64Var(&cfg.CompetitionID, "competition", 0, "ID of a competition with seasons")
+	flag.Uint64Var(&cfg.SeasonID, "season", 0, "ID of a season with teams and fixtures")
+	flag.Uint64Var(&cfg.TeamID, "team", 0, "ID of a team with seasons and fixtures")
+	flag.Uint64Var(&cfg.FixtureID, "fixture", 0, "ID of a fixture")
+	flag.Uint64Var(&cfg.PlayerID, "player", 0, "ID of a player")
+	flag.Uint64Var(&cfg.MissingID, "missing", conformance.DefaultMissingID, "ID no team, fixture or player has")
+	flag.DurationVar(&cfg.Timeout, "timeout", conformance.DefaultTimeout, "time each check has to complete")
+	flag.Parse()
+
+	conn, err := grpc.NewClient(*target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "connecting to %s: %s\n", *target, err)
+		os.Exit(2)
+	}
+
+	defer conn.Close()
+
+	report := conformance.Run(context.Background(), conn, cfg)
+
+	for _, res := range report {
+		fmt.Printf("%s  %s (%s)\n", res.Status, res.Check, res.Duration.Round(time.Millisecond))
+
+		if res.Message != "" {
+			fmt.Printf("      %s\n", res.Message)
+		}
+	}
+
+	fmt.Printf("\n%d passed, %d failed, %d skipped\n", report.Count(conformance.Pass), report.Count(conformance.Fail), report.Count(conformance.Skip))
+
+	if !report.Passed() {
+		conn.Close()
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,266 @@
+// Command statistico-fake-server serves the statistico data services from a directory of JSON and YAML
+// data files, for local development without access to the data service. Files are reloaded when they
+// change, and latency and errors can be simulated:
+//
+//	statistico-fake-server -data ./data -latency 50ms -error TeamService/GetTeamByID=Unavailable -error-rate 0.1
+//
+// Data files hold a statisticofootballdatatest.Dataset, and are merged in name order. The server supports
+// gRPC reflection, so can be explored using tools such as grpcurl.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-football-data-go-grpc-client/statisticofootballdatatest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"log"
+	"math/rand"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// methodFlags collects repeated METHOD=VALUE flags.
+type methodFlags map[string]string
+
+func (m methodFlags) String() string {
+	return fmt.Sprint(map[string]string(m))
+}
+
+func (m methodFlags) Set(v string) error {
+	method, value, ok := strings.Cut(v, "=")
+
+	if !ok {
+		return fmt.Errorf("expected METHOD=VALUE, got %q", v)
+	}
+
+	m[fullMethod(method)] = value
+
+	return nil
+}
+
+// fullMethod returns the full gRPC name of a method such as TeamService/GetTeamByID, or AllMethods for *.
+func fullMethod(method string) string {
+	switch {
+	case method == "*":
+		return statisticofootballdatatest.AllMethods
+	case strings.HasPrefix(method, "/"):
+		return method
+	case strings.HasPrefix(method, "statistico."):
+		return "/" + method
+	default:
+		return "/statistico." + method
+	}
+}
+
+func parseCode(name string) (codes.Code, error) {
+	for c := codes.OK; c <= codes.Unauthenticated; c++ {
+		if strings.EqualFold(strings.ReplaceAll(name, "_", ""), c.String()) {
+			return c, nil
+		}
+	}
+
+	return codes.Unknown, fmt.Errorf("unknown gRPC code %q", name)
+}
+
+func main() {
+	latencies := methodFlags{}
+	errs := methodFlags{}
+
+	addr := flag.String("addr", ":50051", "address to listen on")
+	dir := flag.String("data", "data", "directory of JSON and YAML data files")
+	reload := flag.Duration("reload", time.Second, "interval at which data files are checked for changes, or 0 to disable")
+	latency := flag.Duration("latency", 0, "latency added to every data service call")
+	rate := flag.Float64("error-rate", 1, "fraction of calls to methods set using -error that fail")
+	seed := flag.Int64("seed", time.Now().UnixNano(), "seed deciding which calls fail when -error-rate is below 1")
+	flag.Var(latencies, "method-latency", "latency added to calls to a method on top of -latency, as METHOD=DURATION such as FixtureService/Search=500ms (repeatable)")
+	flag.Var(errs, "error", "gRPC code calls to a method fail with, as METHOD=CODE such as TeamService/GetTeamByID=Unavailable, or *=CODE for every data service method (repeatable)")
+	flag.Parse()
+
+	opts, err := hookOptions(*latency, latencies, errs, *rate, *seed)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ds, err := statisticofootballdatatest.LoadDatasetDir(*dir)
+
+	if err != nil {
+		log.Fatalf("loading data: %s", err)
+	}
+
+	svc := statisticofootballdatatest.NewService(ds)
+
+	srv := grpc.NewServer(statisticofootballdatatest.NewHooks(opts...).ServerOptions()...)
+
+	svc.Register(srv)
+
+	hs := health.NewServer()
+
+	for _, service := range statisticofootballdata.Services {
+		hs.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+	}
+
+	healthpb.RegisterHealthServer(srv, hs)
+	reflection.Register(srv)
+
+	lis, err := net.Listen("tcp", *addr)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if *reload > 0 {
+		go watch(ctx, *dir, *reload, svc)
+	}
+
+	go func() {
+		<-ctx.Done()
+		srv.GracefulStop()
+	}()
+
+	log.Printf("serving %s on %s", describe(ds), lis.Addr())
+
+	if err := srv.Serve(lis); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// hookOptions returns the options of the Hooks simulating latency and errors. Method latencies add to the
+// latency of every data service call, and errors set for every method only fail the data services,
+// leaving health checks and reflection working.
+func hookOptions(latency time.Duration, latencies, errs methodFlags, rate float64, seed int64) ([]statisticofootballdatatest.Option, error) {
+	opts := []statisticofootballdatatest.Option{statisticofootballdatatest.WithLatency(statisticofootballdatatest.AllMethods, latency)}
+
+	for method, value := range latencies {
+		d, err := time.ParseDuration(value)
+
+		if err != nil {
+			return nil, fmt.Errorf("latency of %s: %w", method, err)
+		}
+
+		opts = append(opts, statisticofootballdatatest.WithLatency(method, latency+d))
+	}
+
+	failures := map[string]codes.Code{}
+
+	for method, value := range errs {
+		code, err := parseCode(value)
+
+		if err != nil {
+			return nil, fmt.Errorf("error of %s: %w", method, err)
+		}
+
+		failures[method] = code
+	}
+
+	if len(failures) == 0 {
+		return opts, nil
+	}
+
+	var mu sync.Mutex
+
+	rng := rand.New(rand.NewSource(seed))
+
+	opts = append(opts, statisticofootballdatatest.WithHook(func(_ context.Context, method string, _ any) error {
+		code, ok := failures[method]
+
+		if !ok {
+			if code, ok = failures[statisticofootballdatatest.AllMethods]; !ok || !statisticofootballdatatest.DataServiceMethod(method) {
+				return nil
+			}
+		}
+
+		mu.Lock()
+		fail := rate >= 1 || rng.Float64() < rate
+		mu.Unlock()
+
+		if !fail {
+			return nil
+		}
+
+		return status.Errorf(code, "simulated %s error", code)
+	}))
+
+	return opts, nil
+}
+
+// watch reloads the data files in dir when any are added, removed or modified, keeping the current data
+// if they fail to load.
+func watch(ctx context.Context, dir string, interval time.Duration, svc *statisticofootballdatatest.Service) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	last := snapshot(dir)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		current := snapshot(dir)
+
+		if current == last {
+			continue
+		}
+
+		last = current
+
+		ds, err := statisticofootballdatatest.LoadDatasetDir(dir)
+
+		if err != nil {
+			log.Printf("reloading data: %s", err)
+			continue
+		}
+
+		svc.SetDataset(ds)
+
+		log.Printf("reloaded %s", describe(ds))
+	}
+}
+
+// snapshot returns the names, sizes and modification times of the data files in dir.
+func snapshot(dir string) string {
+	files, err := statisticofootballdatatest.DataFiles(dir)
+
+	if err != nil {
+		return err.Error()
+	}
+
+	var b strings.Builder
+
+	for _, f := range files {
+		info, err := os.Stat(f)
+
+		if err != nil {
+			continue
+		}
+
+		fmt.Fprintf(&b, "%s:%d:%d\n", f, info.Size(), info.ModTime().UnixNano())
+	}
+
+	return b.String()
+}
+
+func describe(ds *statisticofootballdatatest.Dataset) string {
+	return fmt.Sprintf(
+		"%d competitions, %d seasons, %d teams, %d fixtures and %d players",
+		len(ds.Competitions), len(ds.Seasons), len(ds.Teams), len(ds.Fixtures), len(ds.Players),
+	)
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-football-data-go-grpc-client/statisticofootballdatatest"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"testing"
+	"time"
+)
+
+func TestMethodFlags_Set(t *testing.T) {
+	t.Run("keys values by full method name", func(t *testing.T) {
+		t.Helper()
+
+		m := methodFlags{}
+
+		for _, v := range []string{"TeamService/GetTeamByID=Unavailable", "statistico.FixtureService/Search=500ms", "*=Internal"} {
+			if err := m.Set(v); err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+		}
+
+		assert.Equal(t, methodFlags{
+			statistico.TeamService_GetTeamByID_FullMethodName: "Unavailable",
+			statistico.FixtureService_Search_FullMethodName:   "500ms",
+			statisticofootballdatatest.AllMethods:             "Internal",
+		}, m)
+	})
+
+	t.Run("rejects values without a method", func(t *testing.T) {
+		t.Helper()
+
+		err := methodFlags{}.Set("TeamService/GetTeamByID")
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, `expected METHOD=VALUE, got "TeamService/GetTeamByID"`, err.Error())
+	})
+}
+
+func TestParseCode(t *testing.T) {
+	t.Run("parses code names regardless of case and underscores", func(t *testing.T) {
+		t.Helper()
+
+		for name, code := range map[string]codes.Code{
+			"Unavailable":       codes.Unavailable,
+			"unavailable":       codes.Unavailable,
+			"DEADLINE_EXCEEDED": codes.DeadlineExceeded,
+			"NotFound":          codes.NotFound,
+		} {
+			c, err := parseCode(name)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, code, c)
+		}
+	})
+
+	t.Run("rejects unknown codes", func(t *testing.T) {
+		t.Helper()
+
+		_, err := parseCode("Broken")
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, `unknown gRPC code "Broken"`, err.Error())
+	})
+}
+
+func TestHookOptions(t *testing.T) {
+	t.Run("adds method latency to the latency of every call", func(t *testing.T) {
+		t.Helper()
+
+		opts, err := hookOptions(
+			30*time.Millisecond,
+			methodFlags{statistico.TeamService_GetTeamByID_FullMethodName: "30ms"},
+			methodFlags{},
+			1,
+			1,
+		)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		client, ds := newClient(t, opts)
+
+		start := time.Now()
+
+		if _, err := client.Teams.ByID(context.Background(), ds.Teams[0].GetId()); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.GreaterOrEqual(t, time.Since(start), 60*time.Millisecond)
+
+		start = time.Now()
+
+		if _, err := client.Competitions.ByCountryID(context.Background(), statisticofootballdatatest.CountryID); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+	})
+
+	t.Run("fails data service calls but not health checks with errors set for every method", func(t *testing.T) {
+		t.Helper()
+
+		opts, err := hookOptions(0, methodFlags{}, methodFlags{statisticofootballdatatest.AllMethods: "Unavailable"}, 1, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		client, ds := newClient(t, opts)
+
+		_, err = client.Teams.ByID(context.Background(), ds.Teams[0].GetId())
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.IsType(t, statisticofootballdata.ErrorBadGateway{}, err)
+		assert.Contains(t, err.Error(), "simulated Unavailable error")
+
+		report, err := client.Health(context.Background())
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, len(statisticofootballdata.Services), len(report))
+	})
+
+	t.Run("fails calls at the error rate deterministically for a seed", func(t *testing.T) {
+		t.Helper()
+
+		failures := func() []bool {
+			opts, err := hookOptions(
+				0,
+				methodFlags{},
+				methodFlags{statistico.TeamService_GetTeamByID_FullMethodName: "Unavailable"},
+				0.5,
+				42,
+			)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			client, ds := newClient(t, opts)
+
+			var failed []bool
+
+			for i := 0; i < 20; i++ {
+				_, err := client.Teams.ByID(context.Background(), ds.Teams[0].GetId())
+				failed = append(failed, err != nil)
+			}
+
+			return failed
+		}
+
+		first := failures()
+
+		assert.Equal(t, first, failures())
+		assert.Contains(t, first, true)
+		assert.Contains(t, first, false)
+	})
+
+	t.Run("rejects invalid latencies and codes", func(t *testing.T) {
+		t.Helper()
+
+		_, err := hookOptions(0, methodFlags{statistico.TeamService_GetTeamByID_FullMethodName: "soon"}, methodFlags{}, 1, 1)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Contains(t, err.Error(), "latency of "+statistico.TeamService_GetTeamByID_FullMethodName)
+
+		_, err = hookOptions(0, methodFlags{}, methodFlags{statistico.TeamService_GetTeamByID_FullMethodName: "Broken"}, 1, 1)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, `error of /statistico.TeamService/GetTeamByID: unknown gRPC code "Broken"`, err.Error())
+	})
+}
+
+func newClient(t *testing.T, opts []statisticofootballdatatest.Option) (*statisticofootballdata.Client, *statisticofootballdatatest.Dataset) {
+	ds := statisticofootballdatatest.Generate(statisticofootballdatatest.GenerateConfig{Teams: 4})
+
+	srv := statisticofootballdatatest.NewServer(ds, opts...)
+	t.Cleanup(srv.Close)
+
+	client := srv.Client()
+	t.Cleanup(func() { client.Close() })
+
+	return client, ds
+}
@@ -0,0 +1,58 @@
+// Command statistico-generate-data writes a synthetic league Dataset as JSON, for use with the fake server
+// or as test data. The same flags always generate the same data:
+//
+//	statistico-generate-data -seed 42 -competitions 2 -seasons 3 -teams 20 -out data/generated.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"github.com/statistico/statistico-football-data-go-grpc-client/statisticofootballdatatest"
+	"log"
+	"os"
+	"time"
+)
+
+func main() {
+	var cfg statisticofootballdatatest.GenerateConfig
+
+	flag.Int64Var(&cfg.Seed, "seed", 1, "seed of the generated data")
+	flag.IntVar(&cfg.Competitions, "competitions", 1, "number of competitions, each with its own teams")
+	flag.IntVar(&cfg.Seasons, "seasons", 1, "number of seasons of each competition")
+	flag.IntVar(&cfg.Teams, "teams", 20, "number of teams in each competition")
+	flag.IntVar(&cfg.SquadSize, "squad", 18, "number of players in each team")
+	start := flag.String("start", "2020-08-01", "date the first season starts, as YYYY-MM-DD")
+	now := flag.String("now", "", "RFC3339 time from which fixtures are left unplayed, or empty to play every fixture")
+	out := flag.String("out", "", "file to write to, or empty for stdout")
+	flag.Parse()
+
+	var err error
+
+	if cfg.Start, err = time.Parse(time.DateOnly, *start); err != nil {
+		log.Fatalf("parsing -start: %s", err)
+	}
+
+	if *now != "" {
+		if cfg.Now, err = time.Parse(time.RFC3339, *now); err != nil {
+			log.Fatalf("parsing -now: %s", err)
+		}
+	}
+
+	b, err := json.MarshalIndent(statisticofootballdatatest.Generate(cfg), "", "  ")
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	b = append(b, '\n')
+
+	if *out == "" {
+		_, err = os.Stdout.Write(b)
+	} else {
+		err = os.WriteFile(*out, b, 0o644)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -14,43 +14,48 @@ type CompetitionClient interface {
 
 type competitionClient struct {
 	competitionClient statistico.CompetitionServiceClient
+	opts              options
 }
 
 func (c *competitionClient) ByCountryID(ctx context.Context, countryId uint64) ([]*statistico.Competition, error) {
-	competitions := []*statistico.Competition{}
-
 	req := statistico.CompetitionRequest{CountryIds: []uint64{countryId}}
 
-	stream, err := c.competitionClient.ListCompetitions(ctx, &req)
+	return invokeStream(ctx, c.opts, MethodCompetitionByCountryID, &req, func(ctx context.Context, call *Call) ([]*statistico.Competition, error) {
+		competitions := []*statistico.Competition{}
 
-	if err != nil {
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.Internal:
-				return competitions, ErrorExternalServer{err}
-			default:
-				return competitions, ErrorBadGateway{err}
+		stream, err := c.competitionClient.ListCompetitions(ctx, &req)
+
+		if err != nil {
+			if e, ok := status.FromError(err); ok {
+				switch e.Code() {
+				case codes.Internal:
+					return competitions, ErrorExternalServer{err}
+				default:
+					return competitions, ErrorBadGateway{err}
+				}
 			}
 		}
-	}
 
-	for {
-		competition, err := stream.Recv()
+		for {
+			competition, err := stream.Recv()
 
-		if err == io.EOF {
-			break
-		}
+			if err == io.EOF {
+				break
+			}
 
-		if err != nil {
-			return competitions, ErrorExternalServer{err}
-		}
+			if err != nil {
+				return competitions, ErrorExternalServer{err}
+			}
+
+			call.received(competition)
 
-		competitions = append(competitions, competition)
-	}
+			competitions = append(competitions, competition)
+		}
 
-	return competitions, nil
+		return competitions, nil
+	})
 }
 
-func NewCompetitionClient(c statistico.CompetitionServiceClient) CompetitionClient {
-	return &competitionClient{competitionClient: c}
+func NewCompetitionClient(c statistico.CompetitionServiceClient, opts ...Option) CompetitionClient {
+	return &competitionClient{competitionClient: c, opts: newOptions(opts)}
 }
@@ -0,0 +1,472 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"github.com/statistico/statistico-proto/go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"io"
+	"time"
+)
+
+var checks = []check{
+	{"CompetitionClient.ByCountryID returns the competitions of the country", competitionsByCountry},
+	{"EventClient.FixtureEvents returns the events of the fixture", fixtureEvents},
+	{"FixtureClient.ByID returns the fixture", fixtureByID},
+	{"FixtureClient.ByID returns NotFound for a missing fixture", fixtureNotFound},
+	{"FixtureClient.Search filters by season and sorts by date", searchBySeason},
+	{"FixtureClient.Search filters by team and applies the limit", searchByTeam},
+	{"FixtureClient.Search filters by date", searchByDate},
+	{"FixtureClient.Search returns InvalidArgument for an invalid date", searchInvalidDate},
+	{"FixtureClient.Search stream terminates", searchTerminates},
+	{"PlayerClient.ByID returns the player", playerByID},
+	{"PlayerClient.ByID returns NotFound for a missing player", playerNotFound},
+	{"PlayerStatsClient.FixtureStats returns the stats of the fixture", playerStats},
+	{"SeasonClient.ByCompetitionID returns seasons sorted by name", seasonsByCompetition},
+	{"SeasonClient.ByTeamID returns seasons sorted by name", seasonsByTeam},
+	{"TeamClient.ByID returns the team", teamByID},
+	{"TeamClient.ByID returns NotFound for a missing team", teamNotFound},
+	{"TeamClient.BySeasonID returns the teams of the season", teamsBySeason},
+	{"TeamStatClient.Stats returns the stats of the fixture", teamStats},
+}
+
+// expectCode returns an error unless err has a status with code.
+func expectCode(err error, code codes.Code) error {
+	if err == nil {
+		return fmt.Errorf("expected %s error, got nil", code)
+	}
+
+	if status.Code(err) != code {
+		return fmt.Errorf("expected %s error, got %s: %s", code, status.Code(err), err)
+	}
+
+	return nil
+}
+
+// drain receives every item from a stream, returning an error unless it terminates with io.EOF.
+func drain[T any](stream grpc.ServerStreamingClient[T], err error) ([]*T, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*T
+
+	for {
+		item, err := stream.Recv()
+
+		if err == io.EOF {
+			return items, nil
+		}
+
+		if err != nil {
+			return items, fmt.Errorf("stream failed after %d items: %w", len(items), err)
+		}
+
+		items = append(items, item)
+	}
+}
+
+func competitionsByCountry(ctx context.Context, s *suite) error {
+	if s.cfg.CountryID == 0 {
+		return skip("CountryID")
+	}
+
+	competitions, err := s.client.Competitions.ByCountryID(ctx, s.cfg.CountryID)
+
+	if err != nil {
+		return err
+	}
+
+	if len(competitions) == 0 {
+		return fmt.Errorf("expected competitions for country %d, got none", s.cfg.CountryID)
+	}
+
+	for _, c := range competitions {
+		if c.GetId() == 0 || c.GetName() == "" {
+			return fmt.Errorf("competition %d is missing its ID or name", c.GetId())
+		}
+
+		if c.GetCountryId() != s.cfg.CountryID {
+			return fmt.Errorf("competition %d has country %d, expected %d", c.GetId(), c.GetCountryId(), s.cfg.CountryID)
+		}
+	}
+
+	return nil
+}
+
+func fixtureEvents(ctx context.Context, s *suite) error {
+	if s.cfg.FixtureID == 0 {
+		return skip("FixtureID")
+	}
+
+	events, err := s.client.Events.FixtureEvents(ctx, s.cfg.FixtureID)
+
+	if err != nil {
+		return err
+	}
+
+	if events.GetFixtureId() != s.cfg.FixtureID {
+		return fmt.Errorf("events have fixture %d, expected %d", events.GetFixtureId(), s.cfg.FixtureID)
+	}
+
+	return nil
+}
+
+func fixtureByID(ctx context.Context, s *suite) error {
+	if s.cfg.FixtureID == 0 {
+		return skip("FixtureID")
+	}
+
+	f, err := s.client.Fixtures.ByID(ctx, s.cfg.FixtureID)
+
+	if err != nil {
+		return err
+	}
+
+	if uint64(f.GetId()) != s.cfg.FixtureID {
+		return fmt.Errorf("fixture has ID %d, expected %d", f.GetId(), s.cfg.FixtureID)
+	}
+
+	if f.GetCompetition() == nil || f.GetSeason() == nil || f.GetHomeTeam() == nil || f.GetAwayTeam() == nil || f.GetDateTime() == nil {
+		return fmt.Errorf("fixture is missing its competition, season, teams or date")
+	}
+
+	if f.GetHomeTeam().GetId() == f.GetAwayTeam().GetId() {
+		return fmt.Errorf("fixture has team %d playing itself", f.GetHomeTeam().GetId())
+	}
+
+	return nil
+}
+
+func fixtureNotFound(ctx context.Context, s *suite) error {
+	_, err := s.fixtures.FixtureByID(ctx, &statistico.FixtureRequest{FixtureId: s.cfg.MissingID})
+
+	return expectCode(err, codes.NotFound)
+}
+
+func searchBySeason(ctx context.Context, s *suite) error {
+	if s.cfg.SeasonID == 0 {
+		return skip("SeasonID")
+	}
+
+	fixtures, err := s.client.Fixtures.Search(ctx, &statistico.FixtureSearchRequest{
+		SeasonIds: []uint64{s.cfg.SeasonID},
+		Sort:      &wrapperspb.StringValue{Value: "date_asc"},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(fixtures) == 0 {
+		return fmt.Errorf("expected fixtures for season %d, got none", s.cfg.SeasonID)
+	}
+
+	for i, f := range fixtures {
+		if f.GetSeason().GetId() != s.cfg.SeasonID {
+			return fmt.Errorf("fixture %d is in season %d, expected %d", f.GetId(), f.GetSeason().GetId(), s.cfg.SeasonID)
+		}
+
+		if i > 0 && f.GetDateTime().GetUtc() < fixtures[i-1].GetDateTime().GetUtc() {
+			return fmt.Errorf("fixture %d kicks off before fixture %d, expected date_asc order", f.GetId(), fixtures[i-1].GetId())
+		}
+	}
+
+	return nil
+}
+
+func searchByTeam(ctx context.Context, s *suite) error {
+	if s.cfg.TeamID == 0 {
+		return skip("TeamID")
+	}
+
+	const limit = 5
+
+	fixtures, err := s.client.Fixtures.Search(ctx, &statistico.FixtureSearchRequest{
+		TeamId: &wrapperspb.UInt64Value{Value: s.cfg.TeamID},
+		Limit:  &wrapperspb.UInt64Value{Value: limit},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(fixtures) == 0 {
+		return fmt.Errorf("expected fixtures for team %d, got none", s.cfg.TeamID)
+	}
+
+	if len(fixtures) > limit {
+		return fmt.Errorf("expected at most %d fixtures, got %d", limit, len(fixtures))
+	}
+
+	for _, f := range fixtures {
+		if f.GetHomeTeam().GetId() != s.cfg.TeamID && f.GetAwayTeam().GetId() != s.cfg.TeamID {
+			return fmt.Errorf("fixture %d does not involve team %d", f.GetId(), s.cfg.TeamID)
+		}
+	}
+
+	return nil
+}
+
+func searchByDate(ctx context.Context, s *suite) error {
+	if s.cfg.FixtureID == 0 {
+		return skip("FixtureID")
+	}
+
+	f, err := s.client.Fixtures.ByID(ctx, s.cfg.FixtureID)
+
+	if err != nil {
+		return err
+	}
+
+	kickOff := time.Unix(f.GetDateTime().GetUtc(), 0).UTC()
+
+	fixtures, err := s.client.Fixtures.Search(ctx, &statistico.FixtureSearchRequest{
+		SeasonIds:  []uint64{f.GetSeason().GetId()},
+		DateAfter:  &wrapperspb.StringValue{Value: kickOff.Add(-time.Minute).Format(time.RFC3339)},
+		DateBefore: &wrapperspb.StringValue{Value: kickOff.Add(time.Minute).Format(time.RFC3339)},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	found := false
+
+	for _, r := range fixtures {
+		at := time.Unix(r.GetDateTime().GetUtc(), 0)
+
+		if at.Before(kickOff.Add(-time.Minute)) || at.After(kickOff.Add(time.Minute)) {
+			return fmt.Errorf("fixture %d kicks off at %s, outside the dates searched", r.GetId(), at.UTC().Format(time.RFC3339))
+		}
+
+		found = found || r.GetId() == f.GetId()
+	}
+
+	if !found {
+		return fmt.Errorf("expected fixture %d kicking off at %s, got %d other fixtures", f.GetId(), kickOff.Format(time.RFC3339), len(fixtures))
+	}
+
+	return nil
+}
+
+func searchInvalidDate(ctx context.Context, s *suite) error {
+	_, err := drain(s.fixtures.Search(ctx, &statistico.FixtureSearchRequest{
+		DateBefore: &wrapperspb.StringValue{Value: "not a date"},
+	}))
+
+	return expectCode(err, codes.InvalidArgument)
+}
+
+func searchTerminates(ctx context.Context, s *suite) error {
+	req := &statistico.FixtureSearchRequest{Limit: &wrapperspb.UInt64Value{Value: 1}}
+
+	if s.cfg.SeasonID != 0 {
+		req.SeasonIds = []uint64{s.cfg.SeasonID}
+	}
+
+	stream, err := s.fixtures.Search(ctx, req)
+
+	if _, err := drain(stream, err); err != nil {
+		return err
+	}
+
+	// A terminated stream keeps returning io.EOF
+	if _, err := stream.Recv(); err != io.EOF {
+		return fmt.Errorf("expected io.EOF after the stream terminated, got %v", err)
+	}
+
+	return nil
+}
+
+func playerByID(ctx context.Context, s *suite) error {
+	if s.cfg.PlayerID == 0 {
+		return skip("PlayerID")
+	}
+
+	p, err := s.client.Players.ByID(ctx, s.cfg.PlayerID)
+
+	if err != nil {
+		return err
+	}
+
+	if p.GetId() != s.cfg.PlayerID {
+		return fmt.Errorf("player has ID %d, expected %d", p.GetId(), s.cfg.PlayerID)
+	}
+
+	return nil
+}
+
+func playerNotFound(ctx context.Context, s *suite) error {
+	_, err := s.players.GetPlayerByID(ctx, &statistico.PlayerRequest{PlayerId: s.cfg.MissingID})
+
+	return expectCode(err, codes.NotFound)
+}
+
+func playerStats(ctx context.Context, s *suite) error {
+	if s.cfg.FixtureID == 0 {
+		return skip("FixtureID")
+	}
+
+	f, err := s.client.Fixtures.ByID(ctx, s.cfg.FixtureID)
+
+	if err != nil {
+		return err
+	}
+
+	stats, err := s.client.PlayerStats.FixtureStats(ctx, &statistico.FixtureRequest{FixtureId: s.cfg.FixtureID})
+
+	if err != nil {
+		return err
+	}
+
+	teams := map[uint64][]*statistico.PlayerStats{
+		f.GetHomeTeam().GetId(): stats.GetHomeTeam(),
+		f.GetAwayTeam().GetId(): stats.GetAwayTeam(),
+	}
+
+	for team, players := range teams {
+		for _, p := range players {
+			if p.GetTeamId() != team || p.GetFixtureId() != s.cfg.FixtureID {
+				return fmt.Errorf("stats of player %d are for team %d and fixture %d, expected team %d and fixture %d", p.GetPlayerId(), p.GetTeamId(), p.GetFixtureId(), team, s.cfg.FixtureID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func sortedByName(seasons []*statistico.Season, desc bool) error {
+	for i := 1; i < len(seasons); i++ {
+		prev, cur := seasons[i-1].GetName(), seasons[i].GetName()
+
+		if (!desc && cur < prev) || (desc && cur > prev) {
+			return fmt.Errorf("season %q follows %q, expected name order", cur, prev)
+		}
+	}
+
+	return nil
+}
+
+func seasonsByCompetition(ctx context.Context, s *suite) error {
+	if s.cfg.CompetitionID == 0 {
+		return skip("CompetitionID")
+	}
+
+	seasons, err := s.client.Seasons.ByCompetitionID(ctx, s.cfg.CompetitionID, "name_asc")
+
+	if err != nil {
+		return err
+	}
+
+	if len(seasons) == 0 {
+		return fmt.Errorf("expected seasons for competition %d, got none", s.cfg.CompetitionID)
+	}
+
+	return sortedByName(seasons, false)
+}
+
+func seasonsByTeam(ctx context.Context, s *suite) error {
+	if s.cfg.TeamID == 0 {
+		return skip("TeamID")
+	}
+
+	seasons, err := s.client.Seasons.ByTeamID(ctx, s.cfg.TeamID, "name_desc")
+
+	if err != nil {
+		return err
+	}
+
+	if len(seasons) == 0 {
+		return fmt.Errorf("expected seasons for team %d, got none", s.cfg.TeamID)
+	}
+
+	return sortedByName(seasons, true)
+}
+
+func teamByID(ctx context.Context, s *suite) error {
+	if s.cfg.TeamID == 0 {
+		return skip("TeamID")
+	}
+
+	t, err := s.client.Teams.ByID(ctx, s.cfg.TeamID)
+
+	if err != nil {
+		return err
+	}
+
+	if t.GetId() != s.cfg.TeamID || t.GetName() == "" {
+		return fmt.Errorf("team has ID %d and name %q, expected ID %d and a name", t.GetId(), t.GetName(), s.cfg.TeamID)
+	}
+
+	return nil
+}
+
+func teamNotFound(ctx context.Context, s *suite) error {
+	_, err := s.teams.GetTeamByID(ctx, &statistico.TeamRequest{TeamId: s.cfg.MissingID})
+
+	return expectCode(err, codes.NotFound)
+}
+
+func teamsBySeason(ctx context.Context, s *suite) error {
+	if s.cfg.SeasonID == 0 {
+		return skip("SeasonID")
+	}
+
+	teams, err := s.client.Teams.BySeasonID(ctx, s.cfg.SeasonID)
+
+	if err != nil {
+		return err
+	}
+
+	if len(teams) == 0 {
+		return fmt.Errorf("expected teams for season %d, got none", s.cfg.SeasonID)
+	}
+
+	seen := map[uint64]bool{}
+
+	for _, t := range teams {
+		if t.GetId() == 0 || t.GetName() == "" {
+			return fmt.Errorf("team %d is missing its ID or name", t.GetId())
+		}
+
+		if seen[t.GetId()] {
+			return fmt.Errorf("team %d returned more than once", t.GetId())
+		}
+
+		seen[t.GetId()] = true
+	}
+
+	return nil
+}
+
+func teamStats(ctx context.Context, s *suite) error {
+	if s.cfg.FixtureID == 0 {
+		return skip("FixtureID")
+	}
+
+	f, err := s.client.Fixtures.ByID(ctx, s.cfg.FixtureID)
+
+	if err != nil {
+		return err
+	}
+
+	stats, err := s.client.TeamStats.Stats(ctx, &statistico.FixtureRequest{FixtureId: s.cfg.FixtureID})
+
+	if err != nil {
+		return err
+	}
+
+	if h := stats.GetHomeTeam(); h != nil && h.GetTeamId() != f.GetHomeTeam().GetId() {
+		return fmt.Errorf("home team stats are for team %d, expected %d", h.GetTeamId(), f.GetHomeTeam().GetId())
+	}
+
+	if a := stats.GetAwayTeam(); a != nil && a.GetTeamId() != f.GetAwayTeam().GetId() {
+		return fmt.Errorf("away team stats are for team %d, expected %d", a.GetTeamId(), f.GetAwayTeam().GetId())
+	}
+
+	return nil
+}
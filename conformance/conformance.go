@@ -0,0 +1,172 @@
+// Package conformance checks a data service behaves as the clients expect, exercising each wrapped RPC
+// against a target and verifying response shapes, stream termination, NotFound semantics and
+// InvalidArgument errors for bad searches.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"google.golang.org/grpc"
+	"time"
+)
+
+// DefaultMissingID is used by checks for NotFound errors when Config.MissingID is not set.
+const DefaultMissingID = 999999999
+
+// DefaultTimeout is the time each check has to complete when Config.Timeout is not set, after which
+// a stream is considered not to have terminated.
+const DefaultTimeout = 10 * time.Second
+
+// Config holds the IDs of data known to exist in the data service being checked. Checks needing an ID
+// that is not set are skipped.
+type Config struct {
+	CountryID     uint64
+	CompetitionID uint64
+	SeasonID      uint64
+	TeamID        uint64
+	FixtureID     uint64
+	PlayerID      uint64
+	MissingID     uint64
+	Timeout       time.Duration
+}
+
+// Status is the outcome of a check.
+type Status string
+
+const (
+	Pass Status = "PASS"
+	Fail Status = "FAIL"
+	Skip Status = "SKIP"
+)
+
+// Result is the outcome of a check, with a message explaining failures and skips.
+type Result struct {
+	Check    string
+	Status   Status
+	Message  string
+	Duration time.Duration
+}
+
+// Report holds the Result of every check, in the order they were run.
+type Report []Result
+
+// Passed returns true if no check failed.
+func (r Report) Passed() bool {
+	for _, res := range r {
+		if res.Status == Fail {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Count returns the number of checks with status s.
+func (r Report) Count(s Status) int {
+	n := 0
+
+	for _, res := range r {
+		if res.Status == s {
+			n++
+		}
+	}
+
+	return n
+}
+
+// skipped is returned by checks needing an ID that is not configured.
+type skipped struct {
+	reason string
+}
+
+func (s skipped) Error() string {
+	return s.reason
+}
+
+func skip(field string) error {
+	return skipped{reason: field + " not configured"}
+}
+
+// suite holds the clients and configuration used by checks.
+type suite struct {
+	cfg    Config
+	client *statisticofootballdata.Client
+
+	competitions statistico.CompetitionServiceClient
+	events       statistico.EventServiceClient
+	fixtures     statistico.FixtureServiceClient
+	players      statistico.PlayerServiceClient
+	playerStats  statistico.PlayerStatsServiceClient
+	seasons      statistico.SeasonServiceClient
+	teams        statistico.TeamServiceClient
+	teamStats    statistico.TeamStatsServiceClient
+}
+
+type check struct {
+	name string
+	run  func(ctx context.Context, s *suite) error
+}
+
+// Run runs every check against the data service conn is connected to.
+func Run(ctx context.Context, conn grpc.ClientConnInterface, cfg Config) Report {
+	if cfg.MissingID == 0 {
+		cfg.MissingID = DefaultMissingID
+	}
+
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+
+	s := &suite{
+		cfg:          cfg,
+		client:       statisticofootballdata.NewClient(conn),
+		competitions: statistico.NewCompetitionServiceClient(conn),
+		events:       statistico.NewEventServiceClient(conn),
+		fixtures:     statistico.NewFixtureServiceClient(conn),
+		players:      statistico.NewPlayerServiceClient(conn),
+		playerStats:  statistico.NewPlayerStatsServiceClient(conn),
+		seasons:      statistico.NewSeasonServiceClient(conn),
+		teams:        statistico.NewTeamServiceClient(conn),
+		teamStats:    statistico.NewTeamStatsServiceClient(conn),
+	}
+
+	defer s.client.Close()
+
+	report := Report{}
+
+	for _, c := range checks {
+		report = append(report, s.run(ctx, c))
+	}
+
+	return report
+}
+
+func (s *suite) run(ctx context.Context, c check) Result {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	start := time.Now()
+
+	err := c.run(ctx, s)
+
+	res := Result{Check: c.name, Status: Pass, Duration: time.Since(start)}
+
+	var sk skipped
+
+	switch {
+	case errors.As(err, &sk):
+		res.Status = Skip
+		res.Message = sk.reason
+	case err != nil && ctx.Err() != nil:
+		res.Status = Fail
+		res.Message = fmt.Sprintf("did not complete within %s: %s", s.cfg.Timeout, err)
+	case err != nil:
+		res.Status = Fail
+		res.Message = err.Error()
+	}
+
+	return res
+}
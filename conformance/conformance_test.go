@@ -0,0 +1,126 @@
+package conformance_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client/conformance"
+	"github.com/statistico/statistico-football-data-go-grpc-client/statisticofootballdatatest"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("passes every check against a conforming data service", func(t *testing.T) {
+		t.Helper()
+
+		srv := newServer(t)
+
+		report := conformance.Run(context.Background(), srv.Conn(), config())
+
+		for _, res := range report {
+			assert.Equal(t, conformance.Pass, res.Status, "%s: %s", res.Check, res.Message)
+		}
+
+		assert.True(t, report.Passed())
+		assert.Equal(t, 18, len(report))
+	})
+
+	t.Run("skips checks needing IDs that are not configured", func(t *testing.T) {
+		t.Helper()
+
+		srv := newServer(t)
+
+		report := conformance.Run(context.Background(), srv.Conn(), conformance.Config{})
+
+		assert.True(t, report.Passed())
+		assert.Equal(t, 13, report.Count(conformance.Skip))
+		assert.Equal(t, "FixtureID not configured", find(report, "FixtureClient.ByID returns the fixture").Message)
+	})
+
+	t.Run("fails checks for unexpected errors", func(t *testing.T) {
+		t.Helper()
+
+		srv := newServer(t)
+		srv.SetError(statistico.TeamService_GetTeamByID_FullMethodName, status.Error(codes.Internal, "oh damn"))
+
+		report := conformance.Run(context.Background(), srv.Conn(), config())
+
+		assert.False(t, report.Passed())
+		assert.Equal(t, 2, report.Count(conformance.Fail))
+
+		res := find(report, "TeamClient.ByID returns NotFound for a missing team")
+
+		assert.Equal(t, conformance.Fail, res.Status)
+		assert.Equal(t, "expected NotFound error, got Internal: rpc error: code = Internal desc = oh damn", res.Message)
+	})
+
+	t.Run("fails checks for streams that do not terminate", func(t *testing.T) {
+		t.Helper()
+
+		srv := newServer(t)
+		srv.SetLatency(statistico.FixtureService_Search_FullMethodName, time.Second)
+
+		cfg := config()
+		cfg.Timeout = 20 * time.Millisecond
+
+		report := conformance.Run(context.Background(), srv.Conn(), cfg)
+
+		res := find(report, "FixtureClient.Search stream terminates")
+
+		assert.Equal(t, conformance.Fail, res.Status)
+		assert.Contains(t, res.Message, "did not complete within 20ms")
+	})
+
+	t.Run("fails checks for unexpected response shapes", func(t *testing.T) {
+		t.Helper()
+
+		srv := newServer(t)
+
+		ds := srv.Dataset()
+		ds.Teams[0].Name = ""
+
+		report := conformance.Run(context.Background(), srv.Conn(), config())
+
+		res := find(report, "TeamClient.ByID returns the team")
+
+		assert.Equal(t, conformance.Fail, res.Status)
+		assert.Equal(t, `team has ID 1 and name "", expected ID 1 and a name`, res.Message)
+	})
+}
+
+func newServer(t *testing.T) *statisticofootballdatatest.Server {
+	ds, err := statisticofootballdatatest.LoadDataset("../statisticofootballdatatest/testdata/dataset.json")
+
+	if err != nil {
+		t.Fatalf("Expected nil, got %s", err.Error())
+	}
+
+	srv := statisticofootballdatatest.NewServer(ds)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func config() conformance.Config {
+	return conformance.Config{
+		CountryID:     462,
+		CompetitionID: 8,
+		SeasonID:      16036,
+		TeamID:        1,
+		FixtureID:     192,
+		PlayerID:      37,
+	}
+}
+
+func find(report conformance.Report, check string) conformance.Result {
+	for _, res := range report {
+		if res.Check == check {
+			return res
+		}
+	}
+
+	return conformance.Result{}
+}
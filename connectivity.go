@@ -0,0 +1,67 @@
+package statisticofootballdata
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"sync"
+)
+
+var stateRank = map[connectivity.State]int{
+	connectivity.Ready:            0,
+	connectivity.Connecting:       1,
+	connectivity.Idle:             2,
+	connectivity.TransientFailure: 3,
+	connectivity.Shutdown:         4,
+}
+
+// bestState returns the connectivity state of the most usable of conns.
+func bestState(conns []*grpc.ClientConn) connectivity.State {
+	best := connectivity.Shutdown
+
+	for _, conn := range conns {
+		if s := conn.GetState(); stateRank[s] < stateRank[best] {
+			best = s
+		}
+	}
+
+	return best
+}
+
+// waitForStateChange waits until the best state of conns differs from state or ctx is done.
+func waitForStateChange(ctx context.Context, conns []*grpc.ClientConn, state connectivity.State) bool {
+	for {
+		if bestState(conns) != state {
+			return true
+		}
+
+		waitCtx, cancel := context.WithCancel(ctx)
+		changed := make(chan struct{}, len(conns))
+
+		var wg sync.WaitGroup
+
+		for _, conn := range conns {
+			wg.Add(1)
+
+			go func(conn *grpc.ClientConn, s connectivity.State) {
+				defer wg.Done()
+
+				if conn.WaitForStateChange(waitCtx, s) {
+					changed <- struct{}{}
+				}
+			}(conn, conn.GetState())
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-changed:
+		}
+
+		cancel()
+		wg.Wait()
+
+		if ctx.Err() != nil {
+			return false
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package statisticofootballdata
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys the request ID and caller are sent to the data service under.
+const (
+	CallerMetadataKey    = "x-caller"
+	RequestIDMetadataKey = "x-request-id"
+)
+
+type requestIDKey struct{}
+
+type callerKey struct{}
+
+// WithRequestID returns a context carrying id, sent to the data service as the request ID of calls made
+// with the context by clients created using WithCorrelation.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFrom returns the request ID carried by ctx.
+func RequestIDFrom(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+
+	return id, ok && id != ""
+}
+
+// WithCaller returns a context carrying the name of the service or job making calls with the context,
+// sent to the data service by clients created using WithCorrelation.
+func WithCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFrom returns the caller carried by ctx.
+func CallerFrom(ctx context.Context) (string, bool) {
+	caller, ok := ctx.Value(callerKey{}).(string)
+
+	return caller, ok && caller != ""
+}
+
+// RequestIDError is returned by clients created using WithCorrelation, wrapping the error returned by the
+// call with the request ID sent to the data service.
+type RequestIDError struct {
+	RequestID string
+	err       error
+}
+
+func (e RequestIDError) Error() string {
+	return fmt.Sprintf("%s (request ID: %s)", e.err.Error(), e.RequestID)
+}
+
+func (e RequestIDError) Unwrap() error {
+	return e.err
+}
+
+// RequestIDFromError returns the request ID sent to the data service by the call returning err.
+func RequestIDFromError(err error) (string, bool) {
+	var e RequestIDError
+
+	if errors.As(err, &e) {
+		return e.RequestID, true
+	}
+
+	return "", false
+}
+
+// WithCorrelation sends the request ID and caller carried by the context of every call to the data
+// service as gRPC metadata, generating a request ID for calls without one. Errors returned are wrapped
+// in a RequestIDError so failures can be correlated with the logs of the data service.
+func WithCorrelation() Option {
+	return WithMiddleware(CorrelationMiddleware())
+}
+
+// CorrelationMiddleware returns the middleware used by WithCorrelation, for composing with other
+// middleware. Middleware it wraps sees the generated request ID using RequestIDFrom.
+func CorrelationMiddleware() Middleware {
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, call *Call) (any, error) {
+			id, ok := RequestIDFrom(ctx)
+
+			if !ok {
+				id = newRequestID()
+				ctx = WithRequestID(ctx, id)
+			}
+
+			kv := []string{RequestIDMetadataKey, id}
+
+			if caller, ok := CallerFrom(ctx); ok {
+				kv = append(kv, CallerMetadataKey, caller)
+			}
+
+			res, err := next(metadata.AppendToOutgoingContext(ctx, kv...), call)
+
+			if err != nil {
+				return res, RequestIDError{RequestID: id, err: err}
+			}
+
+			return res, nil
+		}
+	}
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,127 @@
+package statisticofootballdata_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestWithCorrelation(t *testing.T) {
+	t.Run("sends request ID and caller carried by context as metadata", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewTeamClient(m, statisticofootballdata.WithCorrelation())
+
+		var md metadata.MD
+
+		m.On("GetTeamByID", mock.Anything, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).
+			Run(func(args mock.Arguments) {
+				md, _ = metadata.FromOutgoingContext(args.Get(0).(context.Context))
+			}).
+			Return(&statistico.Team{Id: 1}, nil)
+
+		ctx := statisticofootballdata.WithRequestID(context.Background(), "abc-123")
+		ctx = statisticofootballdata.WithCaller(ctx, "odds-compiler")
+
+		_, err := client.ByID(ctx, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []string{"abc-123"}, md.Get(statisticofootballdata.RequestIDMetadataKey))
+		assert.Equal(t, []string{"odds-compiler"}, md.Get(statisticofootballdata.CallerMetadataKey))
+		m.AssertExpectations(t)
+	})
+
+	t.Run("generates a request ID when context does not carry one", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewTeamClient(m, statisticofootballdata.WithCorrelation())
+
+		ids := []string{}
+
+		m.On("GetTeamByID", mock.Anything, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).
+			Run(func(args mock.Arguments) {
+				md, _ := metadata.FromOutgoingContext(args.Get(0).(context.Context))
+				ids = append(ids, md.Get(statisticofootballdata.RequestIDMetadataKey)...)
+				assert.Equal(t, 0, len(md.Get(statisticofootballdata.CallerMetadataKey)))
+			}).
+			Return(&statistico.Team{Id: 1}, nil)
+
+		_, _ = client.ByID(context.Background(), 1)
+		_, _ = client.ByID(context.Background(), 1)
+
+		assert.Equal(t, 2, len(ids))
+		assert.Equal(t, 32, len(ids[0]))
+		assert.NotEqual(t, ids[0], ids[1])
+	})
+
+	t.Run("echoes the request ID on errors", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewTeamClient(m, statisticofootballdata.WithCorrelation())
+
+		m.On("GetTeamByID", mock.Anything, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).
+			Return(&statistico.Team{}, status.Error(codes.NotFound, "not found"))
+
+		_, err := client.ByID(statisticofootballdata.WithRequestID(context.Background(), "abc-123"), 1)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		id, ok := statisticofootballdata.RequestIDFromError(err)
+
+		assert.True(t, ok)
+		assert.Equal(t, "abc-123", id)
+		assert.True(t, errors.As(err, &statisticofootballdata.ErrorNotFound{}))
+		assert.Equal(t, "resource with ID '1' does not exist. Error: rpc error: code = NotFound desc = not found (request ID: abc-123)", err.Error())
+	})
+
+	t.Run("makes generated request ID available to wrapped middleware", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		buf := new(bytes.Buffer)
+
+		client := statisticofootballdata.NewTeamClient(
+			m,
+			statisticofootballdata.WithCorrelation(),
+			statisticofootballdata.WithLogger(newJSONLogger(buf)),
+		)
+
+		m.On("GetTeamByID", mock.Anything, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).
+			Return(&statistico.Team{}, status.Error(codes.Unavailable, "unavailable"))
+
+		_, err := client.ByID(context.Background(), 1)
+
+		id, _ := statisticofootballdata.RequestIDFromError(err)
+
+		logs := decodeLogs(t, buf)
+
+		assert.Equal(t, id, logs[0]["request_id"])
+	})
+}
+
+func TestRequestIDFromError(t *testing.T) {
+	t.Run("returns false for errors without request ID", func(t *testing.T) {
+		t.Helper()
+
+		_, ok := statisticofootballdata.RequestIDFromError(errors.New("oh damn"))
+
+		assert.False(t, ok)
+	})
+}
@@ -1,9 +1,19 @@
 package statisticofootballdata
 
 import (
+	"errors"
 	"fmt"
 )
 
+// Error classes returned by ErrorClass, named after the error types returned by the clients.
+const (
+	ErrorClassBadGateway      = "BadGateway"
+	ErrorClassExternalServer  = "ExternalServer"
+	ErrorClassInvalidArgument = "InvalidArgument"
+	ErrorClassNotFound        = "NotFound"
+	ErrorClassUnknown         = "Unknown"
+)
+
 type ErrorBadGateway struct {
 	err error
 }
@@ -36,3 +46,30 @@ type ErrorNotFound struct {
 func (e ErrorNotFound) Error() string {
 	return fmt.Sprintf("resource with ID '%d' does not exist. Error: %s", e.ID, e.err.Error())
 }
+
+// ErrorClass returns the class of an error returned by a client, for use as a low cardinality label
+// in logs, traces and metrics. It returns an empty string for a nil error and ErrorClassUnknown for
+// errors not mapped by the clients.
+func ErrorClass(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	if errors.As(err, &ErrorNotFound{}) {
+		return ErrorClassNotFound
+	}
+
+	if errors.As(err, &ErrorBadGateway{}) {
+		return ErrorClassBadGateway
+	}
+
+	if errors.As(err, &ErrorExternalServer{}) {
+		return ErrorClassExternalServer
+	}
+
+	if errors.As(err, &ErrorInvalidArgument{}) {
+		return ErrorClassInvalidArgument
+	}
+
+	return ErrorClassUnknown
+}
@@ -0,0 +1,63 @@
+package statisticofootballdata_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestErrorClass(t *testing.T) {
+	t.Run("returns the class of errors returned by clients", func(t *testing.T) {
+		t.Helper()
+
+		classes := map[codes.Code]string{
+			codes.InvalidArgument: statisticofootballdata.ErrorClassInvalidArgument,
+			codes.Internal:        statisticofootballdata.ErrorClassExternalServer,
+			codes.Unavailable:     statisticofootballdata.ErrorClassBadGateway,
+		}
+
+		for code, class := range classes {
+			m := new(MockProtoTeamStatsClient)
+			client := statisticofootballdata.NewTeamStatClient(m)
+
+			ctx := context.Background()
+			req := statistico.FixtureRequest{FixtureId: 1}
+
+			m.On("GetTeamStatsForFixture", ctx, &req, []grpc.CallOption(nil)).Return(&statistico.TeamStatsResponse{}, status.Error(code, "error"))
+
+			_, err := client.Stats(ctx, &req)
+
+			assert.Equal(t, class, statisticofootballdata.ErrorClass(err))
+			assert.Equal(t, class, statisticofootballdata.ErrorClass(fmt.Errorf("wrapped: %w", err)))
+		}
+	})
+
+	t.Run("returns not found class for not found errors", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoPlayerClient)
+		client := statisticofootballdata.NewPlayerClient(m)
+
+		ctx := context.Background()
+
+		m.On("GetPlayerByID", ctx, &statistico.PlayerRequest{PlayerId: 1}, []grpc.CallOption(nil)).Return(&statistico.Player{}, status.Error(codes.NotFound, "not found"))
+
+		_, err := client.ByID(ctx, 1)
+
+		assert.Equal(t, statisticofootballdata.ErrorClassNotFound, statisticofootballdata.ErrorClass(err))
+	})
+
+	t.Run("returns unknown class for other errors and empty string for nil", func(t *testing.T) {
+		t.Helper()
+
+		assert.Equal(t, statisticofootballdata.ErrorClassUnknown, statisticofootballdata.ErrorClass(errors.New("oh damn")))
+		assert.Equal(t, "", statisticofootballdata.ErrorClass(nil))
+	})
+}
@@ -13,29 +13,32 @@ type EventClient interface {
 
 type eventClient struct {
 	client statistico.EventServiceClient
+	opts   options
 }
 
 func (e eventClient) FixtureEvents(ctx context.Context, fixtureID uint64) (*statistico.FixtureEventsResponse, error) {
 	req := statistico.FixtureRequest{FixtureId: fixtureID}
 
-	res, err := e.client.FixtureEvents(ctx, &req)
-
-	if err != nil {
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.NotFound:
-				return nil, ErrorNotFound{fixtureID, err}
-			case codes.Internal:
-				return nil, ErrorExternalServer{err}
-			default:
-				return nil, ErrorBadGateway{err}
+	return invokeUnary(ctx, e.opts, MethodEventFixtureEvents, &req, func(ctx context.Context) (*statistico.FixtureEventsResponse, error) {
+		res, err := e.client.FixtureEvents(ctx, &req)
+
+		if err != nil {
+			if e, ok := status.FromError(err); ok {
+				switch e.Code() {
+				case codes.NotFound:
+					return nil, ErrorNotFound{fixtureID, err}
+				case codes.Internal:
+					return nil, ErrorExternalServer{err}
+				default:
+					return nil, ErrorBadGateway{err}
+				}
 			}
 		}
-	}
 
-	return res, nil
+		return res, nil
+	})
 }
 
-func NewEventClient(c statistico.EventServiceClient) EventClient {
-	return &eventClient{client: c}
+func NewEventClient(c statistico.EventServiceClient, opts ...Option) EventClient {
+	return &eventClient{client: c, opts: newOptions(opts)}
 }
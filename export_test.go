@@ -0,0 +1,21 @@
+package statisticofootballdata
+
+import "time"
+
+// WithClock sets the function a ResponseCache, and the MemoryCache it creates by default, read the
+// current time from, so tests can expire entries without sleeping.
+func WithClock(now func() time.Time) CacheOption {
+	return func(c *ResponseCache) {
+		c.clock = now
+	}
+}
+
+// SetClock sets the function m reads the current time from.
+func (m *MemoryCache) SetClock(now func() time.Time) {
+	m.clock = now
+}
+
+// SetClock sets the function f reads the current time from.
+func (f *FileCache) SetClock(now func() time.Time) {
+	f.clock = now
+}
@@ -0,0 +1,384 @@
+package statisticofootballdata
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"io"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultHealthCheckInterval is how often a FailoverConn checks whether preferred endpoints have recovered.
+const DefaultHealthCheckInterval = 10 * time.Second
+
+// WithHealthCheckInterval sets how often a FailoverConn checks whether the endpoints preferred to the
+// one in use have recovered.
+func WithHealthCheckInterval(d time.Duration) DialOption {
+	return func(o *dialOptions) {
+		o.healthCheckInterval = d
+	}
+}
+
+// WithFailoverCodes sets the gRPC codes a FailoverConn fails over to the next endpoint on, defaulting to
+// Unavailable, which gRPC also returns for bad gateway and service unavailable responses from proxies.
+func WithFailoverCodes(c ...codes.Code) DialOption {
+	return func(o *dialOptions) {
+		o.failoverCodes = c
+	}
+}
+
+type endpoint struct {
+	target    string
+	conn      *grpc.ClientConn
+	health    healthpb.HealthClient
+	unhealthy atomic.Bool
+}
+
+// FailoverConn is a grpc.ClientConnInterface sending calls to the first available of an ordered list of
+// endpoints, such as a primary data service followed by read replicas. Calls failing with a failover
+// code, including streams failing to open or failing before their first message is received, are
+// retried against the following endpoints. An endpoint that fails is skipped by later calls until its
+// health check passes, unless every endpoint has failed. The endpoint serving a call, or the last
+// endpoint tried if every endpoint fails, is recorded in the ResponseInfo attached to its context.
+type FailoverConn struct {
+	endpoints []*endpoint
+	codes     map[codes.Code]bool
+	active    atomic.Int32
+	cancel    context.CancelFunc
+}
+
+func (f *FailoverConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	pending := f.candidates()
+
+	_, err := f.try(ctx, &pending, func(e *endpoint) error {
+		return e.conn.Invoke(ctx, method, args, reply, opts...)
+	})
+
+	return err
+}
+
+// NewStream opens a stream on the first available endpoint. Streams sending a single request, such as
+// those of server streaming methods, are reopened on the following endpoints if they fail with a failover
+// code before the first message is received.
+func (f *FailoverConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	s := &failoverStream{conn: f, ctx: ctx, desc: desc, method: method, opts: opts, pending: f.candidates()}
+
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+
+	if desc.ClientStreams {
+		return s.ClientStream, nil
+	}
+
+	return s, nil
+}
+
+// candidates returns the indexes of the endpoints to try a call against in order, starting with the active
+// endpoint, skipping endpoints that have failed until their health check passes. If every endpoint has
+// failed they are all tried.
+func (f *FailoverConn) candidates() []int {
+	active := int(f.active.Load())
+
+	var healthy, all []int
+
+	for i := range f.endpoints {
+		idx := (active + i) % len(f.endpoints)
+
+		all = append(all, idx)
+
+		if !f.endpoints[idx].unhealthy.Load() {
+			healthy = append(healthy, idx)
+		}
+	}
+
+	if len(healthy) == 0 {
+		return all
+	}
+
+	return healthy
+}
+
+// try calls fn with each of the pending endpoints in turn, removing them from pending, until it succeeds
+// or fails with an error not warranting failover. It returns the index of the last endpoint tried.
+func (f *FailoverConn) try(ctx context.Context, pending *[]int, fn func(e *endpoint) error) (int, error) {
+	var err error
+
+	idx := -1
+
+	for len(*pending) > 0 {
+		idx, *pending = (*pending)[0], (*pending)[1:]
+
+		e := f.endpoints[idx]
+
+		err = fn(e)
+
+		responseInfoFrom(ctx).servedBy(e.target)
+
+		if err == nil || !f.shouldFailover(ctx, err) {
+			return idx, err
+		}
+
+		f.failed(idx)
+	}
+
+	return idx, err
+}
+
+// failed marks the endpoint at idx as unhealthy and, if it is active, makes the next healthy endpoint
+// active in its place.
+func (f *FailoverConn) failed(idx int) {
+	f.endpoints[idx].unhealthy.Store(true)
+
+	for next := idx + 1; next < len(f.endpoints); next++ {
+		if !f.endpoints[next].unhealthy.Load() {
+			f.active.CompareAndSwap(int32(idx), int32(next))
+			return
+		}
+	}
+}
+
+func (f *FailoverConn) shouldFailover(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	return f.codes[status.Code(err)]
+}
+
+// failoverStream is a stream that is reopened on the next endpoint if it fails before the first message
+// is received, replaying the messages already sent.
+type failoverStream struct {
+	grpc.ClientStream
+	conn     *FailoverConn
+	ctx      context.Context
+	desc     *grpc.StreamDesc
+	method   string
+	opts     []grpc.CallOption
+	pending  []int
+	idx      int
+	sent     []any
+	closed   bool
+	received bool
+}
+
+func (s *failoverStream) SendMsg(m any) error {
+	s.sent = append(s.sent, m)
+
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *failoverStream) CloseSend() error {
+	s.closed = true
+
+	return s.ClientStream.CloseSend()
+}
+
+func (s *failoverStream) RecvMsg(m any) error {
+	for {
+		err := s.ClientStream.RecvMsg(m)
+
+		if s.received || err == nil || len(s.pending) == 0 || !s.conn.shouldFailover(s.ctx, err) {
+			s.received = s.received || err == nil
+			return err
+		}
+
+		s.conn.failed(s.idx)
+
+		if err := s.open(); err != nil {
+			return err
+		}
+	}
+}
+
+// open opens the stream on the first of the pending endpoints able to open it.
+func (s *failoverStream) open() error {
+	idx, err := s.conn.try(s.ctx, &s.pending, func(e *endpoint) error {
+		stream, err := e.conn.NewStream(s.ctx, s.desc, s.method, s.opts...)
+
+		if err != nil {
+			return err
+		}
+
+		s.ClientStream = stream
+
+		return s.replay()
+	})
+
+	s.idx = idx
+
+	return err
+}
+
+// replay sends the messages sent on the previous stream. A send failing with io.EOF means the stream has
+// ended, and its status is returned by RecvMsg.
+func (s *failoverStream) replay() error {
+	for _, m := range s.sent {
+		if err := s.ClientStream.SendMsg(m); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+		}
+	}
+
+	if s.closed {
+		return s.ClientStream.CloseSend()
+	}
+
+	return nil
+}
+
+// Endpoint returns the target of the endpoint calls are currently sent to.
+func (f *FailoverConn) Endpoint() string {
+	return f.endpoints[f.active.Load()].target
+}
+
+// failBack health checks the endpoints that have failed and those preferred to the active endpoint,
+// making the first healthy endpoint preferred to the active endpoint active again.
+func (f *FailoverConn) failBack(ctx context.Context, timeout time.Duration) {
+	active := int(f.active.Load())
+
+	for i, e := range f.endpoints {
+		if i >= active && !e.unhealthy.Load() {
+			continue
+		}
+
+		if !e.healthy(ctx, timeout) {
+			continue
+		}
+
+		e.unhealthy.Store(false)
+
+		if i < active {
+			f.active.Store(int32(i))
+			active = i
+		}
+	}
+}
+
+func (e *endpoint) healthy(ctx context.Context, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res, err := e.health.Check(ctx, &healthpb.HealthCheckRequest{})
+
+	if err != nil {
+		// Endpoints without a health service are healthy as long as they can be reached
+		return status.Code(err) == codes.Unimplemented
+	}
+
+	return res.GetStatus() == healthpb.HealthCheckResponse_SERVING
+}
+
+func (f *FailoverConn) checkHealth(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f.failBack(ctx, interval)
+		}
+	}
+}
+
+// GetState returns the best connectivity state of the endpoints.
+func (f *FailoverConn) GetState() connectivity.State {
+	return bestState(f.conns())
+}
+
+// WaitForStateChange waits until the state returned by GetState differs from state or ctx is done.
+func (f *FailoverConn) WaitForStateChange(ctx context.Context, state connectivity.State) bool {
+	return waitForStateChange(ctx, f.conns(), state)
+}
+
+func (f *FailoverConn) conns() []*grpc.ClientConn {
+	conns := make([]*grpc.ClientConn, len(f.endpoints))
+
+	for i, e := range f.endpoints {
+		conns[i] = e.conn
+	}
+
+	return conns
+}
+
+// Connect causes every endpoint to leave idle and connect.
+func (f *FailoverConn) Connect() {
+	for _, e := range f.endpoints {
+		e.conn.Connect()
+	}
+}
+
+// Close stops health checking and closes the connection to every endpoint.
+func (f *FailoverConn) Close() error {
+	f.cancel()
+
+	var errs []error
+
+	for _, e := range f.endpoints {
+		errs = append(errs, e.conn.Close())
+	}
+
+	return errors.Join(errs...)
+}
+
+// NewFailoverConn creates a FailoverConn over targets in order of preference.
+func NewFailoverConn(targets []string, opts ...DialOption) (*FailoverConn, error) {
+	if len(targets) == 0 {
+		return nil, errors.New("at least one target is required")
+	}
+
+	o := newDialOptions(opts)
+
+	f := &FailoverConn{codes: map[codes.Code]bool{}}
+
+	for _, c := range o.failoverCodes {
+		f.codes[c] = true
+	}
+
+	for _, target := range targets {
+		conn, err := grpc.NewClient(target, o.grpcDialOptions()...)
+
+		if err != nil {
+			for _, e := range f.endpoints {
+				e.conn.Close()
+			}
+
+			return nil, err
+		}
+
+		f.endpoints = append(f.endpoints, &endpoint{target: target, conn: conn, health: healthpb.NewHealthClient(conn)})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	f.cancel = cancel
+
+	go f.checkHealth(ctx, o.healthCheckInterval)
+
+	return f, nil
+}
+
+// DialFailover creates a FailoverConn over targets in order of preference and a Client using it. The
+// connections are closed when the Client is closed.
+func DialFailover(targets []string, opts ...DialOption) (*Client, error) {
+	conn, err := NewFailoverConn(targets, opts...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c := NewClient(conn, newDialOptions(opts).client...)
+	c.close = conn.Close
+
+	return c, nil
+}
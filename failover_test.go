@@ -0,0 +1,344 @@
+package statisticofootballdata_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFailoverConn(t *testing.T) {
+	t.Run("sends calls to the first endpoint while it is available", func(t *testing.T) {
+		t.Helper()
+
+		primary := startTeamServer(t, "primary")
+		defer primary.stop()
+
+		replica := startTeamServer(t, "replica")
+		defer replica.stop()
+
+		client, err := statisticofootballdata.DialFailover([]string{primary.addr, replica.addr})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		ctx, info := statisticofootballdata.WithResponseInfo(context.Background())
+
+		team, err := client.Teams.ByID(ctx, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "primary", team.GetName())
+		assert.Equal(t, primary.addr, info.Endpoint)
+	})
+
+	t.Run("fails over to the next endpoint if the first is unavailable", func(t *testing.T) {
+		t.Helper()
+
+		primary := startTeamServer(t, "primary")
+		primary.stop()
+
+		replica := startTeamServer(t, "replica")
+		defer replica.stop()
+
+		conn, err := statisticofootballdata.NewFailoverConn([]string{primary.addr, replica.addr})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer conn.Close()
+
+		client := statisticofootballdata.NewTeamClient(statistico.NewTeamServiceClient(conn))
+
+		ctx, info := statisticofootballdata.WithResponseInfo(context.Background())
+
+		team, err := client.ByID(ctx, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "replica", team.GetName())
+		assert.Equal(t, replica.addr, info.Endpoint)
+		assert.Equal(t, replica.addr, conn.Endpoint())
+
+		ctx, info = statisticofootballdata.WithResponseInfo(context.Background())
+
+		teams, err := client.BySeasonID(ctx, 16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "replica", teams[0].GetName())
+		assert.Equal(t, replica.addr, info.Endpoint)
+	})
+
+	t.Run("fails back to the first endpoint once it is healthy", func(t *testing.T) {
+		t.Helper()
+
+		primary := startTeamServer(t, "primary")
+		defer primary.stop()
+
+		replica := startTeamServer(t, "replica")
+		defer replica.stop()
+
+		primary.unavailable.Store(true)
+		primary.health.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+		conn, err := statisticofootballdata.NewFailoverConn(
+			[]string{primary.addr, replica.addr},
+			statisticofootballdata.WithHealthCheckInterval(20*time.Millisecond),
+		)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer conn.Close()
+
+		client := statisticofootballdata.NewTeamClient(statistico.NewTeamServiceClient(conn))
+
+		team, err := client.ByID(context.Background(), 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "replica", team.GetName())
+
+		primary.unavailable.Store(false)
+
+		time.Sleep(100 * time.Millisecond)
+
+		assert.Equal(t, replica.addr, conn.Endpoint())
+
+		primary.health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+
+		assert.Eventually(t, func() bool { return conn.Endpoint() == primary.addr }, 5*time.Second, 10*time.Millisecond)
+
+		team, err = client.ByID(context.Background(), 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "primary", team.GetName())
+	})
+
+	t.Run("fails over streams failing before the first message is received", func(t *testing.T) {
+		t.Helper()
+
+		primary := startTeamServer(t, "primary")
+		defer primary.stop()
+
+		replica := startTeamServer(t, "replica")
+		defer replica.stop()
+
+		primary.unavailable.Store(true)
+
+		conn, err := statisticofootballdata.NewFailoverConn([]string{primary.addr, replica.addr})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer conn.Close()
+
+		client := statisticofootballdata.NewTeamClient(statistico.NewTeamServiceClient(conn))
+
+		ctx, info := statisticofootballdata.WithResponseInfo(context.Background())
+
+		teams, err := client.BySeasonID(ctx, 16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(teams))
+		assert.Equal(t, "replica", teams[0].GetName())
+		assert.Equal(t, replica.addr, info.Endpoint)
+		assert.Equal(t, replica.addr, conn.Endpoint())
+	})
+
+	t.Run("skips failed endpoints until their health check passes", func(t *testing.T) {
+		t.Helper()
+
+		primary := startTeamServer(t, "primary")
+		defer primary.stop()
+
+		replica := startTeamServer(t, "replica")
+		defer replica.stop()
+
+		primary.unavailable.Store(true)
+
+		conn, err := statisticofootballdata.NewFailoverConn([]string{primary.addr, replica.addr})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer conn.Close()
+
+		client := statisticofootballdata.NewTeamClient(statistico.NewTeamServiceClient(conn))
+
+		if _, err := client.ByID(context.Background(), 1); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		replica.unavailable.Store(true)
+
+		ctx, info := statisticofootballdata.WithResponseInfo(context.Background())
+
+		_, err = client.ByID(ctx, 1)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.IsType(t, statisticofootballdata.ErrorBadGateway{}, err)
+		assert.Equal(t, replica.addr, info.Endpoint)
+		assert.Equal(t, int32(1), primary.calls.Load())
+		assert.Equal(t, int32(2), replica.calls.Load())
+	})
+
+	t.Run("records the last endpoint tried if every endpoint fails", func(t *testing.T) {
+		t.Helper()
+
+		primary := startTeamServer(t, "primary")
+		primary.stop()
+
+		replica := startTeamServer(t, "replica")
+		replica.stop()
+
+		conn, err := statisticofootballdata.NewFailoverConn([]string{primary.addr, replica.addr})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer conn.Close()
+
+		client := statisticofootballdata.NewTeamClient(statistico.NewTeamServiceClient(conn))
+
+		ctx, info := statisticofootballdata.WithResponseInfo(context.Background())
+
+		_, err = client.ByID(ctx, 1)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, replica.addr, info.Endpoint)
+	})
+
+	t.Run("does not fail over on errors returned by the data service", func(t *testing.T) {
+		t.Helper()
+
+		primary := startTeamServer(t, "primary")
+		defer primary.stop()
+
+		replica := startTeamServer(t, "replica")
+		defer replica.stop()
+
+		conn, err := statisticofootballdata.NewFailoverConn([]string{primary.addr, replica.addr})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer conn.Close()
+
+		client := statisticofootballdata.NewTeamClient(statistico.NewTeamServiceClient(conn))
+
+		ctx, info := statisticofootballdata.WithResponseInfo(context.Background())
+
+		_, err = client.ByID(ctx, 404)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.IsType(t, statisticofootballdata.ErrorNotFound{}, err)
+		assert.Equal(t, primary.addr, info.Endpoint)
+		assert.Equal(t, primary.addr, conn.Endpoint())
+	})
+
+	t.Run("returns error if no targets are provided", func(t *testing.T) {
+		t.Helper()
+
+		_, err := statisticofootballdata.NewFailoverConn(nil)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+	})
+}
+
+type teamServer struct {
+	statistico.UnimplementedTeamServiceServer
+	name        string
+	addr        string
+	health      *health.Server
+	unavailable atomic.Bool
+	calls       atomic.Int32
+	stop        func()
+}
+
+func (s *teamServer) GetTeamByID(_ context.Context, r *statistico.TeamRequest) (*statistico.Team, error) {
+	s.calls.Add(1)
+
+	if s.unavailable.Load() {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+
+	if r.GetTeamId() == 404 {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &statistico.Team{Id: r.GetTeamId(), Name: s.name}, nil
+}
+
+func (s *teamServer) GetTeamsBySeasonId(_ *statistico.SeasonTeamsRequest, stream grpc.ServerStreamingServer[statistico.Team]) error {
+	if s.unavailable.Load() {
+		return status.Error(codes.Unavailable, "unavailable")
+	}
+
+	return stream.Send(&statistico.Team{Id: 1, Name: s.name})
+}
+
+func startTeamServer(t *testing.T, name string) *teamServer {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("Expected nil, got %s", err.Error())
+	}
+
+	s := &teamServer{name: name, addr: lis.Addr().String(), health: health.NewServer()}
+
+	srv := grpc.NewServer()
+
+	statistico.RegisterTeamServiceServer(srv, s)
+	healthpb.RegisterHealthServer(srv, s.health)
+
+	go srv.Serve(lis)
+
+	s.stop = srv.Stop
+
+	return s
+}
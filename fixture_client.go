@@ -15,66 +15,73 @@ type FixtureClient interface {
 
 type fixtureClient struct {
 	client statistico.FixtureServiceClient
+	opts   options
 }
 
 func (f *fixtureClient) ByID(ctx context.Context, fixtureID uint64) (*statistico.Fixture, error) {
 	request := statistico.FixtureRequest{FixtureId: fixtureID}
 
-	fixture, err := f.client.FixtureByID(ctx, &request)
-
-	if err != nil {
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.NotFound:
-				return nil, ErrorNotFound{fixtureID, err}
-			case codes.Internal:
-				return nil, ErrorExternalServer{err}
-			default:
-				return nil, ErrorBadGateway{err}
+	return invokeUnary(ctx, f.opts, MethodFixtureByID, &request, func(ctx context.Context) (*statistico.Fixture, error) {
+		fixture, err := f.client.FixtureByID(ctx, &request)
+
+		if err != nil {
+			if e, ok := status.FromError(err); ok {
+				switch e.Code() {
+				case codes.NotFound:
+					return nil, ErrorNotFound{fixtureID, err}
+				case codes.Internal:
+					return nil, ErrorExternalServer{err}
+				default:
+					return nil, ErrorBadGateway{err}
+				}
 			}
 		}
-	}
 
-	return fixture, nil
+		return fixture, nil
+	})
 }
 
 func (f *fixtureClient) Search(ctx context.Context, req *statistico.FixtureSearchRequest) ([]*statistico.Fixture, error) {
-	fixtures := []*statistico.Fixture{}
-
-	stream, err := f.client.Search(ctx, req)
-
-	if err != nil {
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.InvalidArgument:
-				return fixtures, ErrorInvalidArgument{err}
-			case codes.Internal:
-				return fixtures, ErrorExternalServer{err}
-			default:
-				return fixtures, ErrorBadGateway{err}
+	return invokeStream(ctx, f.opts, MethodFixtureSearch, req, func(ctx context.Context, call *Call) ([]*statistico.Fixture, error) {
+		fixtures := []*statistico.Fixture{}
+
+		stream, err := f.client.Search(ctx, req)
+
+		if err != nil {
+			if e, ok := status.FromError(err); ok {
+				switch e.Code() {
+				case codes.InvalidArgument:
+					return fixtures, ErrorInvalidArgument{err}
+				case codes.Internal:
+					return fixtures, ErrorExternalServer{err}
+				default:
+					return fixtures, ErrorBadGateway{err}
+				}
 			}
+
+			return fixtures, err
 		}
 
-		return fixtures, err
-	}
+		for {
+			fixture, err := stream.Recv()
 
-	for {
-		fixture, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
 
-		if err == io.EOF {
-			break
-		}
+			if err != nil {
+				return fixtures, ErrorExternalServer{err: err}
+			}
 
-		if err != nil {
-			return fixtures, ErrorExternalServer{err: err}
-		}
+			call.received(fixture)
 
-		fixtures = append(fixtures, fixture)
-	}
+			fixtures = append(fixtures, fixture)
+		}
 
-	return fixtures, nil
+		return fixtures, nil
+	})
 }
 
-func NewFixtureClient(p statistico.FixtureServiceClient) FixtureClient {
-	return &fixtureClient{client: p}
+func NewFixtureClient(p statistico.FixtureServiceClient, opts ...Option) FixtureClient {
+	return &fixtureClient{client: p, opts: newOptions(opts)}
 }
@@ -0,0 +1,53 @@
+package statisticofootballdata
+
+import (
+	statistico "github.com/statistico/statistico-proto/go"
+	"time"
+)
+
+// DefaultFullTime is how long after kick-off a fixture is assumed to have finished, allowing for half
+// time, stoppage time, extra time and penalties.
+const DefaultFullTime = 3 * time.Hour
+
+// FixtureStatus describes where a fixture is in its lifecycle.
+type FixtureStatus int
+
+const (
+	FixtureStatusUpcoming FixtureStatus = iota
+	FixtureStatusInPlay
+	FixtureStatusCompleted
+)
+
+func (s FixtureStatus) String() string {
+	switch s {
+	case FixtureStatusUpcoming:
+		return "upcoming"
+	case FixtureStatusInPlay:
+		return "in_play"
+	case FixtureStatusCompleted:
+		return "completed"
+	default:
+		return "unknown"
+	}
+}
+
+// StatusOf derives the status of a fixture at the given time. The data service does not return a
+// status with fixtures, so the status is inferred from the kick-off time with fixtures treated as in
+// play until fullTime has passed. Fixtures without a kick-off time are treated as upcoming, as they
+// cannot be known to have been played.
+func StatusOf(f *statistico.Fixture, now time.Time, fullTime time.Duration) FixtureStatus {
+	if f.GetDateTime() == nil {
+		return FixtureStatusUpcoming
+	}
+
+	kickOff := time.Unix(f.GetDateTime().GetUtc(), 0)
+
+	switch {
+	case now.Before(kickOff):
+		return FixtureStatusUpcoming
+	case now.Before(kickOff.Add(fullTime)):
+		return FixtureStatusInPlay
+	default:
+		return FixtureStatusCompleted
+	}
+}
@@ -0,0 +1,48 @@
+package statisticofootballdata_test
+
+import (
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestStatusOf(t *testing.T) {
+	kickOff := time.Date(2024, 8, 17, 15, 0, 0, 0, time.UTC)
+
+	fixture := statistico.Fixture{Id: 1, DateTime: &statistico.Date{Utc: kickOff.Unix()}}
+
+	tests := []struct {
+		name   string
+		now    time.Time
+		status statisticofootballdata.FixtureStatus
+	}{
+		{"upcoming before kick-off", kickOff.Add(-time.Minute), statisticofootballdata.FixtureStatusUpcoming},
+		{"in play at kick-off", kickOff, statisticofootballdata.FixtureStatusInPlay},
+		{"in play before full time", kickOff.Add(2 * time.Hour), statisticofootballdata.FixtureStatusInPlay},
+		{"completed after full time", kickOff.Add(statisticofootballdata.DefaultFullTime), statisticofootballdata.FixtureStatusCompleted},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Helper()
+
+			status := statisticofootballdata.StatusOf(&fixture, tc.now, statisticofootballdata.DefaultFullTime)
+
+			assert.Equal(t, tc.status, status)
+		})
+	}
+}
+
+func TestStatusOf_WithoutKickOff(t *testing.T) {
+	status := statisticofootballdata.StatusOf(&statistico.Fixture{Id: 2}, time.Now(), statisticofootballdata.DefaultFullTime)
+
+	assert.Equal(t, statisticofootballdata.FixtureStatusUpcoming, status)
+}
+
+func TestFixtureStatus_String(t *testing.T) {
+	assert.Equal(t, "upcoming", statisticofootballdata.FixtureStatusUpcoming.String())
+	assert.Equal(t, "in_play", statisticofootballdata.FixtureStatusInPlay.String())
+	assert.Equal(t, "completed", statisticofootballdata.FixtureStatusCompleted.String())
+}
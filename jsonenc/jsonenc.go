@@ -0,0 +1,143 @@
+// Package jsonenc encodes the messages returned by the clients as JSON with stable output, for serving
+// them from HTTP APIs. Messages are encoded using the protobuf JSON mapping, so fields are named
+// consistently and enums are encoded as strings, with whitespace normalised so the same message always
+// encodes to the same bytes. Types from the model package are encoded through their proto types, so
+// encode identically to the messages they were converted from.
+package jsonenc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"reflect"
+)
+
+// Option configures how messages are encoded.
+type Option func(o *options)
+
+type options struct {
+	marshal protojson.MarshalOptions
+	indent  string
+}
+
+// WithProtoNames names fields as they are in the proto definitions, such as home_team, rather than in
+// lowerCamelCase, such as homeTeam.
+func WithProtoNames() Option {
+	return func(o *options) {
+		o.marshal.UseProtoNames = true
+	}
+}
+
+// WithEmitUnpopulated includes fields that are not set, as zero values or null, rather than omitting
+// them.
+func WithEmitUnpopulated() Option {
+	return func(o *options) {
+		o.marshal.EmitUnpopulated = true
+	}
+}
+
+// WithEnumNumbers encodes enums as numbers rather than as strings.
+func WithEnumNumbers() Option {
+	return func(o *options) {
+		o.marshal.UseEnumNumbers = true
+	}
+}
+
+// WithIndent indents the output of Marshal, with each level of nesting indented by indent. It has no
+// effect on a Writer, which writes each message on a single line.
+func WithIndent(indent string) Option {
+	return func(o *options) {
+		o.indent = indent
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// Marshal encodes v as JSON. v is a proto message, a model type, or a slice of either, which is encoded
+// as an array.
+func Marshal(v any, opts ...Option) ([]byte, error) {
+	o := newOptions(opts)
+
+	var b bytes.Buffer
+
+	if err := o.encode(&b, v); err != nil {
+		return nil, err
+	}
+
+	if o.indent == "" {
+		return b.Bytes(), nil
+	}
+
+	var out bytes.Buffer
+
+	if err := json.Indent(&out, b.Bytes(), "", o.indent); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
+
+// encode writes v to b as compact JSON. protojson deliberately varies its whitespace between builds, so
+// its output is compacted to keep it stable.
+func (o options) encode(b *bytes.Buffer, v any) error {
+	if m, ok := message(v); ok {
+		raw, err := o.marshal.Marshal(m)
+
+		if err != nil {
+			return err
+		}
+
+		return json.Compact(b, raw)
+	}
+
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice {
+		b.WriteByte('[')
+
+		for i := 0; i < rv.Len(); i++ {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+
+			if err := o.encode(b, rv.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+
+		b.WriteByte(']')
+
+		return nil
+	}
+
+	return fmt.Errorf("jsonenc: cannot encode %T, expected a proto message, model type or slice of either", v)
+}
+
+// message returns v as a proto message, converting model types using their ToProto method.
+func message(v any) (proto.Message, bool) {
+	if m, ok := v.(proto.Message); ok {
+		return m, true
+	}
+
+	if v == nil {
+		return nil, false
+	}
+
+	method := reflect.ValueOf(v).MethodByName("ToProto")
+
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return nil, false
+	}
+
+	m, ok := method.Call(nil)[0].Interface().(proto.Message)
+
+	return m, ok
+}
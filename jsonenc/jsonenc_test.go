@@ -0,0 +1,158 @@
+package jsonenc_test
+
+import (
+	"github.com/statistico/statistico-football-data-go-grpc-client/jsonenc"
+	"github.com/statistico/statistico-football-data-go-grpc-client/model"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"testing"
+)
+
+func TestMarshal(t *testing.T) {
+	t.Run("encodes messages using lowerCamelCase names without whitespace", func(t *testing.T) {
+		t.Helper()
+
+		b, err := jsonenc.Marshal(fixture())
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(
+			t,
+			`{"id":"192","season":{"id":"16036","name":"2019/2020","isCurrent":true},"homeTeam":{"id":"1","name":"West Ham United","shortCode":"WHU"},"awayTeam":{"id":"18","name":"Chelsea"},"dateTime":{"utc":"1568473200","rfc":"2019-09-14T15:00:00Z"}}`,
+			string(b),
+		)
+	})
+
+	t.Run("names fields as in the proto definitions", func(t *testing.T) {
+		t.Helper()
+
+		b, err := jsonenc.Marshal(&statistico.Team{Id: 1, ShortCode: wrapperspb.String("WHU")}, jsonenc.WithProtoNames())
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, `{"id":"1","short_code":"WHU"}`, string(b))
+	})
+
+	t.Run("includes unpopulated fields", func(t *testing.T) {
+		t.Helper()
+
+		b, err := jsonenc.Marshal(&statistico.Season{Id: 1}, jsonenc.WithEmitUnpopulated())
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, `{"id":"1","name":"","isCurrent":null}`, string(b))
+	})
+
+	t.Run("encodes enums as strings or numbers", func(t *testing.T) {
+		t.Helper()
+
+		req := &statistico.UpdateStrategyRequest{Type: statistico.StrategyTypeEnum_SIMULATED}
+
+		b, err := jsonenc.Marshal(req)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, `{"type":"SIMULATED"}`, string(b))
+
+		b, err = jsonenc.Marshal(req, jsonenc.WithEnumNumbers())
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, `{"type":1}`, string(b))
+	})
+
+	t.Run("encodes slices as arrays and indents", func(t *testing.T) {
+		t.Helper()
+
+		teams := []*statistico.Team{{Id: 1, Name: "West Ham United"}, {Id: 18, Name: "Chelsea"}}
+
+		b, err := jsonenc.Marshal(teams)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, `[{"id":"1","name":"West Ham United"},{"id":"18","name":"Chelsea"}]`, string(b))
+
+		b, err = jsonenc.Marshal(teams[:1], jsonenc.WithIndent("  "))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "[\n  {\n    \"id\": \"1\",\n    \"name\": \"West Ham United\"\n  }\n]", string(b))
+
+		b, err = jsonenc.Marshal([]*statistico.Team{})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, `[]`, string(b))
+	})
+
+	t.Run("encodes model types as the messages they convert from", func(t *testing.T) {
+		t.Helper()
+
+		want, err := jsonenc.Marshal(fixture())
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		got, err := jsonenc.Marshal(model.FixtureFromProto(fixture()))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, string(want), string(got))
+
+		got, err = jsonenc.Marshal([]model.Team{{ID: 1, Name: "West Ham United"}})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, `[{"id":"1","name":"West Ham United"}]`, string(got))
+	})
+
+	t.Run("returns an error for other types", func(t *testing.T) {
+		t.Helper()
+
+		_, err := jsonenc.Marshal(map[string]int{"a": 1})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "jsonenc: cannot encode map[string]int, expected a proto message, model type or slice of either", err.Error())
+
+		_, err = jsonenc.Marshal(nil)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+	})
+}
+
+func fixture() *statistico.Fixture {
+	return &statistico.Fixture{
+		Id:       192,
+		Season:   &statistico.Season{Id: 16036, Name: "2019/2020", IsCurrent: wrapperspb.Bool(true)},
+		HomeTeam: &statistico.Team{Id: 1, Name: "West Ham United", ShortCode: wrapperspb.String("WHU")},
+		AwayTeam: &statistico.Team{Id: 18, Name: "Chelsea"},
+		DateTime: &statistico.Date{Utc: 1568473200, Rfc: "2019-09-14T15:00:00Z"},
+	}
+}
@@ -0,0 +1,138 @@
+package jsonenc
+
+import (
+	"bytes"
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"reflect"
+	"sync"
+)
+
+// Writer writes messages as newline delimited JSON, one message per line, flushing the underlying writer
+// after each line if it supports flushing, as http.ResponseWriter and bufio.Writer do. Writers are safe
+// for concurrent use.
+type Writer struct {
+	mu   sync.Mutex
+	w    io.Writer
+	opts options
+	err  error
+}
+
+// NewWriter returns a Writer writing to w.
+func NewWriter(w io.Writer, opts ...Option) *Writer {
+	o := newOptions(opts)
+	o.indent = ""
+
+	return &Writer{w: w, opts: o}
+}
+
+// Write writes v as a line of JSON, or each item of v on its own line if v is a slice. v is a proto
+// message, a model type, or a slice of either. Once a write fails, every later write returns the same
+// error.
+func (w *Writer) Write(v any) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err != nil {
+		return w.err
+	}
+
+	if _, ok := message(v); !ok {
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice {
+			for i := 0; i < rv.Len(); i++ {
+				if w.err = w.line(rv.Index(i).Interface()); w.err != nil {
+					return w.err
+				}
+			}
+
+			return nil
+		}
+	}
+
+	w.err = w.line(v)
+
+	return w.err
+}
+
+// Err returns the error of the first write that failed.
+func (w *Writer) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.err
+}
+
+func (w *Writer) line(v any) error {
+	var b bytes.Buffer
+
+	if err := w.opts.encode(&b, v); err != nil {
+		return err
+	}
+
+	b.WriteByte('\n')
+
+	if _, err := w.w.Write(b.Bytes()); err != nil {
+		return err
+	}
+
+	switch f := w.w.(type) {
+	case interface{ Flush() error }:
+		return f.Flush()
+	case interface{ Flush() }:
+		f.Flush()
+	}
+
+	return nil
+}
+
+type contextKey struct{}
+
+// NewContext returns a context carrying w, to which calls made with the context through the Stream
+// middleware write their messages.
+func NewContext(ctx context.Context, w *Writer) context.Context {
+	return context.WithValue(ctx, contextKey{}, w)
+}
+
+// Stream returns middleware writing each message received by a streaming call, such as a fixture search,
+// to the Writer carried by the call's context as it arrives, so responses can be streamed to a client
+// before the call completes. Messages returned by later middleware without being received from the data
+// service are written once the call returns. Calls whose context carries no Writer are unaffected, and
+// the clients still return every message.
+//
+// Responses served by a cached client, such as one created using
+// statisticofootballdata.NewCachedFixtureClient, never reach the middleware and are not written, so write
+// the messages returned by cached clients yourself.
+//
+// A call fails with the Writer's error if writing any of its messages fails.
+func Stream() statisticofootballdata.Middleware {
+	return func(next statisticofootballdata.Invoker) statisticofootballdata.Invoker {
+		return func(ctx context.Context, call *statisticofootballdata.Call) (any, error) {
+			w, ok := ctx.Value(contextKey{}).(*Writer)
+
+			if !ok || !call.Streaming {
+				return next(ctx, call)
+			}
+
+			received := 0
+
+			call.OnRecv(func(m proto.Message) {
+				received++
+				_ = w.Write(m)
+			})
+
+			res, err := next(ctx, call)
+
+			if msgs, ok := res.([]proto.Message); ok && received == 0 {
+				_ = w.Write(msgs)
+			}
+
+			if err == nil {
+				err = w.Err()
+			}
+
+			return res, err
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package jsonenc_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-football-data-go-grpc-client/jsonenc"
+	"github.com/statistico/statistico-football-data-go-grpc-client/statisticofootballdatatest"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWriter(t *testing.T) {
+	t.Run("writes a line per message and flushes", func(t *testing.T) {
+		t.Helper()
+
+		rec := httptest.NewRecorder()
+		w := jsonenc.NewWriter(rec, jsonenc.WithIndent("  "))
+
+		if err := w.Write(&statistico.Team{Id: 1, Name: "West Ham United"}); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		if err := w.Write([]*statistico.Team{{Id: 18, Name: "Chelsea"}, {Id: 19, Name: "Arsenal"}}); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(
+			t,
+			"{\"id\":\"1\",\"name\":\"West Ham United\"}\n{\"id\":\"18\",\"name\":\"Chelsea\"}\n{\"id\":\"19\",\"name\":\"Arsenal\"}\n",
+			rec.Body.String(),
+		)
+		assert.True(t, rec.Flushed)
+	})
+
+	t.Run("returns the first error from every later write", func(t *testing.T) {
+		t.Helper()
+
+		w := jsonenc.NewWriter(failingWriter{})
+
+		err := w.Write(&statistico.Team{Id: 1})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "disk full", err.Error())
+		assert.Equal(t, err, w.Write(&statistico.Team{Id: 18}))
+		assert.Equal(t, err, w.Err())
+	})
+}
+
+func TestStream(t *testing.T) {
+	t.Run("writes search results as they are received", func(t *testing.T) {
+		t.Helper()
+
+		ds, err := statisticofootballdatatest.LoadDataset("../statisticofootballdatatest/testdata/dataset.json")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		srv := statisticofootballdatatest.NewServer(ds)
+		t.Cleanup(srv.Close)
+
+		client := srv.Client(statisticofootballdata.WithMiddleware(jsonenc.Stream()))
+
+		var buf bytes.Buffer
+
+		ctx := jsonenc.NewContext(context.Background(), jsonenc.NewWriter(&buf))
+
+		fixtures, err := client.Fixtures.Search(ctx, &statistico.FixtureSearchRequest{
+			SeasonIds: []uint64{16036},
+			TeamId:    &wrapperspb.UInt64Value{Value: 18},
+		})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(fixtures))
+
+		want, err := jsonenc.Marshal(fixtures[0])
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		lines := bytes.Split(bytes.TrimSuffix(buf.Bytes(), []byte("\n")), []byte("\n"))
+
+		assert.Equal(t, 2, len(lines))
+		assert.Equal(t, string(want), string(lines[0]))
+
+		buf.Reset()
+
+		if _, err := client.Teams.ByID(ctx, 1); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		if _, err := client.Fixtures.Search(context.Background(), &statistico.FixtureSearchRequest{}); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 0, buf.Len())
+	})
+
+	t.Run("writes messages returned by later middleware without being received", func(t *testing.T) {
+		t.Helper()
+
+		stub := func(next statisticofootballdata.Invoker) statisticofootballdata.Invoker {
+			return func(ctx context.Context, call *statisticofootballdata.Call) (any, error) {
+				return []proto.Message{&statistico.Team{Id: 1}, &statistico.Team{Id: 18}}, nil
+			}
+		}
+
+		invoke := statisticofootballdata.Chain(jsonenc.Stream(), stub)(nil)
+
+		var buf bytes.Buffer
+
+		ctx := jsonenc.NewContext(context.Background(), jsonenc.NewWriter(&buf))
+
+		if _, err := invoke(ctx, &statisticofootballdata.Call{Streaming: true}); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "{\"id\":\"1\"}\n{\"id\":\"18\"}\n", buf.String())
+	})
+
+	t.Run("fails calls when writing fails", func(t *testing.T) {
+		t.Helper()
+
+		srv := statisticofootballdatatest.NewServer(statisticofootballdatatest.Generate(statisticofootballdatatest.GenerateConfig{Teams: 4}))
+		t.Cleanup(srv.Close)
+
+		client := srv.Client(statisticofootballdata.WithMiddleware(jsonenc.Stream()))
+
+		ctx := jsonenc.NewContext(context.Background(), jsonenc.NewWriter(failingWriter{}))
+
+		_, err := client.Teams.BySeasonID(ctx, 1)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, "disk full", err.Error())
+	})
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
@@ -0,0 +1,160 @@
+package statisticofootballdata
+
+import (
+	"context"
+	"google.golang.org/protobuf/proto"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// LoggingOption configures the logging middleware.
+type LoggingOption func(c *loggingConfig)
+
+type loggingConfig struct {
+	success  slog.Level
+	errors   map[string]slog.Level
+	slow     time.Duration
+	sampling map[string]uint64
+}
+
+// WithSuccessLevel sets the level calls completing without error are logged at, defaulting to debug.
+func WithSuccessLevel(level slog.Level) LoggingOption {
+	return func(c *loggingConfig) {
+		c.success = level
+	}
+}
+
+// WithErrorLevel sets the level calls failing with an error of class, as returned by ErrorClass, are
+// logged at. Not found errors default to info, invalid arguments to warn and other errors to error.
+func WithErrorLevel(class string, level slog.Level) LoggingOption {
+	return func(c *loggingConfig) {
+		c.errors[class] = level
+	}
+}
+
+// WithSlowThreshold logs calls taking longer than d at warn level or above, regardless of outcome.
+func WithSlowThreshold(d time.Duration) LoggingOption {
+	return func(c *loggingConfig) {
+		c.slow = d
+	}
+}
+
+// WithSampling logs one in every n successful calls to method. Failed and slow calls are always logged.
+func WithSampling(method string, n uint64) LoggingOption {
+	return func(c *loggingConfig) {
+		c.sampling[method] = n
+	}
+}
+
+// WithLogger logs every call made by the client to logger with its method, the IDs and request ID,
+// its duration, the number of items streamed and the class of any error returned.
+func WithLogger(logger *slog.Logger, opts ...LoggingOption) Option {
+	return WithMiddleware(LoggingMiddleware(logger, opts...))
+}
+
+// LoggingMiddleware returns the middleware used by WithLogger, for composing with other middleware.
+func LoggingMiddleware(logger *slog.Logger, opts ...LoggingOption) Middleware {
+	c := loggingConfig{
+		success: slog.LevelDebug,
+		errors: map[string]slog.Level{
+			ErrorClassNotFound:        slog.LevelInfo,
+			ErrorClassInvalidArgument: slog.LevelWarn,
+		},
+		sampling: map[string]uint64{},
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	var counts sync.Map
+
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, call *Call) (any, error) {
+			start := time.Now()
+
+			var items atomic.Int64
+
+			if call.Streaming {
+				call.OnRecv(func(proto.Message) {
+					items.Add(1)
+				})
+			}
+
+			res, err := next(ctx, call)
+
+			duration := time.Since(start)
+			slow := c.slow > 0 && duration > c.slow
+			class := ErrorClass(err)
+
+			level := c.success
+
+			if err != nil {
+				level = slog.LevelError
+
+				if l, ok := c.errors[class]; ok {
+					level = l
+				}
+			}
+
+			if slow && level < slog.LevelWarn {
+				level = slog.LevelWarn
+			}
+
+			if err == nil && !slow {
+				if n := c.sampling[call.Method]; n > 1 {
+					count, _ := counts.LoadOrStore(call.Method, new(atomic.Uint64))
+
+					if count.(*atomic.Uint64).Add(1)%n != 1 {
+						return res, err
+					}
+				}
+			}
+
+			if !logger.Enabled(ctx, level) {
+				return res, err
+			}
+
+			attrs := append([]slog.Attr{slog.String("method", call.Method)}, requestLogAttrs(call.Request)...)
+			attrs = append(attrs, slog.Duration("duration", duration))
+
+			if id, ok := RequestIDFrom(ctx); ok {
+				attrs = append(attrs, slog.String("request_id", id))
+			}
+
+			if call.Streaming {
+				attrs = append(attrs, slog.Int64("items", items.Load()))
+			}
+
+			if slow {
+				attrs = append(attrs, slog.Bool("slow", true))
+			}
+
+			if err != nil {
+				attrs = append(attrs, slog.String("error_class", class), slog.String("error", err.Error()))
+			}
+
+			logger.LogAttrs(ctx, level, "data service call", attrs...)
+
+			return res, err
+		}
+	}
+}
+
+// requestLogAttrs returns attributes identifying the resources a request is for.
+func requestLogAttrs(req proto.Message) []slog.Attr {
+	var attrs []slog.Attr
+
+	for _, f := range RequestFields(req) {
+		if f.Repeated {
+			attrs = append(attrs, slog.Any(f.Name, f.IDs))
+			continue
+		}
+
+		attrs = append(attrs, slog.Uint64(f.Name, f.IDs[0]))
+	}
+
+	return attrs
+}
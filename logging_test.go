@@ -0,0 +1,228 @@
+package statisticofootballdata_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithLogger(t *testing.T) {
+	t.Run("logs successful calls with method, ids and duration at debug level", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		buf := new(bytes.Buffer)
+
+		client := statisticofootballdata.NewTeamClient(m, statisticofootballdata.WithLogger(newJSONLogger(buf)))
+
+		ctx := context.Background()
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{Id: 1}, nil)
+
+		_, err := client.ByID(ctx, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		logs := decodeLogs(t, buf)
+
+		assert.Equal(t, 1, len(logs))
+		assert.Equal(t, "DEBUG", logs[0]["level"])
+		assert.Equal(t, "data service call", logs[0]["msg"])
+		assert.Equal(t, "TeamClient.ByID", logs[0]["method"])
+		assert.Equal(t, float64(1), logs[0]["team_id"])
+		assert.Contains(t, logs[0], "duration")
+		assert.NotContains(t, logs[0], "error_class")
+	})
+
+	t.Run("logs the number of items received by streaming methods", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		stream := new(MockTeamStream)
+		buf := new(bytes.Buffer)
+
+		client := statisticofootballdata.NewTeamClient(m, statisticofootballdata.WithLogger(newJSONLogger(buf)))
+
+		ctx := context.Background()
+
+		m.On("GetTeamsBySeasonId", ctx, &statistico.SeasonTeamsRequest{SeasonId: 16036}, []grpc.CallOption(nil)).Return(stream, nil)
+		stream.On("Recv").Twice().Return(&statistico.Team{Id: 1}, nil)
+		stream.On("Recv").Once().Return(&statistico.Team{}, io.EOF)
+
+		_, err := client.BySeasonID(ctx, 16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		logs := decodeLogs(t, buf)
+
+		assert.Equal(t, float64(16036), logs[0]["season_id"])
+		assert.Equal(t, float64(2), logs[0]["items"])
+	})
+
+	t.Run("logs errors at the level configured for their class", func(t *testing.T) {
+		t.Helper()
+
+		buf := new(bytes.Buffer)
+		ctx := context.Background()
+
+		levels := map[codes.Code]string{
+			codes.NotFound:    "INFO",
+			codes.Unavailable: "WARN",
+		}
+
+		for code, level := range levels {
+			buf.Reset()
+
+			m := new(MockProtoTeamClient)
+
+			client := statisticofootballdata.NewTeamClient(m, statisticofootballdata.WithLogger(
+				newJSONLogger(buf),
+				statisticofootballdata.WithErrorLevel(statisticofootballdata.ErrorClassBadGateway, slog.LevelWarn),
+			))
+
+			m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{}, status.Error(code, "oh damn"))
+
+			_, err := client.ByID(ctx, 1)
+
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+
+			logs := decodeLogs(t, buf)
+
+			assert.Equal(t, level, logs[0]["level"])
+			assert.Equal(t, statisticofootballdata.ErrorClass(err), logs[0]["error_class"])
+			assert.Equal(t, err.Error(), logs[0]["error"])
+		}
+	})
+
+	t.Run("logs errors at error level by default", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		buf := new(bytes.Buffer)
+
+		client := statisticofootballdata.NewTeamClient(m, statisticofootballdata.WithLogger(newJSONLogger(buf)))
+
+		ctx := context.Background()
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{}, status.Error(codes.Unavailable, "unavailable"))
+
+		_, err := client.ByID(ctx, 1)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		logs := decodeLogs(t, buf)
+
+		assert.Equal(t, "ERROR", logs[0]["level"])
+		assert.Equal(t, "BadGateway", logs[0]["error_class"])
+	})
+
+	t.Run("logs slow calls at warn level", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		buf := new(bytes.Buffer)
+
+		delay := func(next statisticofootballdata.Invoker) statisticofootballdata.Invoker {
+			return func(ctx context.Context, call *statisticofootballdata.Call) (any, error) {
+				time.Sleep(5 * time.Millisecond)
+				return next(ctx, call)
+			}
+		}
+
+		client := statisticofootballdata.NewTeamClient(
+			m,
+			statisticofootballdata.WithLogger(newJSONLogger(buf), statisticofootballdata.WithSlowThreshold(time.Millisecond)),
+			statisticofootballdata.WithMiddleware(delay),
+		)
+
+		ctx := context.Background()
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{Id: 1}, nil)
+
+		_, err := client.ByID(ctx, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		logs := decodeLogs(t, buf)
+
+		assert.Equal(t, "WARN", logs[0]["level"])
+		assert.Equal(t, true, logs[0]["slow"])
+	})
+
+	t.Run("samples successful calls but logs every error", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		buf := new(bytes.Buffer)
+
+		client := statisticofootballdata.NewTeamClient(m, statisticofootballdata.WithLogger(
+			newJSONLogger(buf),
+			statisticofootballdata.WithSampling(statisticofootballdata.MethodTeamByID, 3),
+		))
+
+		ctx := context.Background()
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{Id: 1}, nil)
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 2}, []grpc.CallOption(nil)).Return(&statistico.Team{}, status.Error(codes.NotFound, "not found"))
+
+		for i := 0; i < 6; i++ {
+			_, _ = client.ByID(ctx, 1)
+		}
+
+		_, _ = client.ByID(ctx, 2)
+		_, _ = client.ByID(ctx, 2)
+
+		logs := decodeLogs(t, buf)
+
+		assert.Equal(t, 4, len(logs))
+		assert.Equal(t, "DEBUG", logs[0]["level"])
+		assert.Equal(t, "DEBUG", logs[1]["level"])
+		assert.Equal(t, "INFO", logs[2]["level"])
+		assert.Equal(t, "INFO", logs[3]["level"])
+	})
+}
+
+func newJSONLogger(buf *bytes.Buffer) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
+
+func decodeLogs(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	logs := []map[string]any{}
+
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+
+		entry := map[string]any{}
+
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		logs = append(logs, entry)
+	}
+
+	return logs
+}
@@ -0,0 +1,33 @@
+package statisticofootballdata
+
+// Method names identify each wrapper method in caching configuration, middleware and telemetry.
+const (
+	MethodCompetitionByCountryID  = "CompetitionClient.ByCountryID"
+	MethodEventFixtureEvents      = "EventClient.FixtureEvents"
+	MethodFixtureByID             = "FixtureClient.ByID"
+	MethodFixtureSearch           = "FixtureClient.Search"
+	MethodPlayerByID              = "PlayerClient.ByID"
+	MethodPlayerStatsFixtureStats = "PlayerStatsClient.FixtureStats"
+	MethodSeasonByCompetitionID   = "SeasonClient.ByCompetitionID"
+	MethodSeasonByTeamID          = "SeasonClient.ByTeamID"
+	MethodTeamByID                = "TeamClient.ByID"
+	MethodTeamBySeasonID          = "TeamClient.BySeasonID"
+	MethodTeamStatStats           = "TeamStatClient.Stats"
+)
+
+// Methods returns the names of every wrapper method.
+func Methods() []string {
+	return []string{
+		MethodCompetitionByCountryID,
+		MethodEventFixtureEvents,
+		MethodFixtureByID,
+		MethodFixtureSearch,
+		MethodPlayerByID,
+		MethodPlayerStatsFixtureStats,
+		MethodSeasonByCompetitionID,
+		MethodSeasonByTeamID,
+		MethodTeamByID,
+		MethodTeamBySeasonID,
+		MethodTeamStatStats,
+	}
+}
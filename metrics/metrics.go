@@ -0,0 +1,161 @@
+// Package metrics provides Prometheus instrumentation for the data service clients, recording request
+// counts, latency, stream volume and in flight calls for every client method.
+package metrics
+
+import (
+	"context"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"google.golang.org/protobuf/proto"
+	"sync"
+	"time"
+)
+
+// ErrorClassNone is the error_class label value of requests completing without error.
+const ErrorClassNone = "none"
+
+// Option configures a Collector.
+type Option func(c *config)
+
+type config struct {
+	namespace      string
+	latencyBuckets []float64
+	itemBuckets    []float64
+}
+
+// WithNamespace sets the namespace prefixing metric names, defaulting to statistico_football_data.
+func WithNamespace(ns string) Option {
+	return func(c *config) {
+		c.namespace = ns
+	}
+}
+
+// WithLatencyBuckets sets the buckets, in seconds, of the latency and time to first item histograms.
+func WithLatencyBuckets(buckets []float64) Option {
+	return func(c *config) {
+		c.latencyBuckets = buckets
+	}
+}
+
+// WithItemBuckets sets the buckets of the items per stream histogram.
+func WithItemBuckets(buckets []float64) Option {
+	return func(c *config) {
+		c.itemBuckets = buckets
+	}
+}
+
+// Collector records metrics for calls made through its Middleware.
+type Collector struct {
+	requests  *prometheus.CounterVec
+	latency   *prometheus.HistogramVec
+	items     *prometheus.HistogramVec
+	firstItem *prometheus.HistogramVec
+	inFlight  *prometheus.GaugeVec
+}
+
+// Middleware returns client middleware recording metrics for every call.
+func (c *Collector) Middleware() statisticofootballdata.Middleware {
+	return func(next statisticofootballdata.Invoker) statisticofootballdata.Invoker {
+		return func(ctx context.Context, call *statisticofootballdata.Call) (any, error) {
+			start := time.Now()
+
+			inFlight := c.inFlight.WithLabelValues(call.Method)
+			inFlight.Inc()
+			defer inFlight.Dec()
+
+			var mu sync.Mutex
+			var items int
+
+			if call.Streaming {
+				call.OnRecv(func(proto.Message) {
+					mu.Lock()
+					defer mu.Unlock()
+
+					if items == 0 {
+						c.firstItem.WithLabelValues(call.Method).Observe(time.Since(start).Seconds())
+					}
+
+					items++
+				})
+			}
+
+			res, err := next(ctx, call)
+
+			c.latency.WithLabelValues(call.Method).Observe(time.Since(start).Seconds())
+
+			if call.Streaming {
+				mu.Lock()
+				c.items.WithLabelValues(call.Method).Observe(float64(items))
+				mu.Unlock()
+			}
+
+			class := statisticofootballdata.ErrorClass(err)
+
+			if class == "" {
+				class = ErrorClassNone
+			}
+
+			c.requests.WithLabelValues(call.Method, class).Inc()
+
+			return res, err
+		}
+	}
+}
+
+// NewCollector creates a Collector and registers its metrics with reg:
+//
+//   - requests_total counts requests by method and error_class
+//   - request_duration_seconds is a histogram of request latency by method
+//   - stream_items is a histogram of the number of items received per stream by method
+//   - stream_first_item_seconds is a histogram of the time taken to receive the first item of a stream
+//   - requests_in_flight is a gauge of calls in progress by method
+func NewCollector(reg prometheus.Registerer, opts ...Option) (*Collector, error) {
+	cfg := config{
+		namespace:      "statistico_football_data",
+		latencyBuckets: prometheus.DefBuckets,
+		itemBuckets:    prometheus.ExponentialBuckets(1, 4, 8),
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	c := &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: cfg.namespace,
+			Name:      "requests_total",
+			Help:      "Requests made to the data service by client method and error class.",
+		}, []string{"method", "error_class"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: cfg.namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of requests made to the data service by client method.",
+			Buckets:   cfg.latencyBuckets,
+		}, []string{"method"}),
+		items: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: cfg.namespace,
+			Name:      "stream_items",
+			Help:      "Items received per stream from the data service by client method.",
+			Buckets:   cfg.itemBuckets,
+		}, []string{"method"}),
+		firstItem: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: cfg.namespace,
+			Name:      "stream_first_item_seconds",
+			Help:      "Time taken to receive the first item of a stream from the data service by client method.",
+			Buckets:   cfg.latencyBuckets,
+		}, []string{"method"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: cfg.namespace,
+			Name:      "requests_in_flight",
+			Help:      "Requests to the data service in progress by client method.",
+		}, []string{"method"}),
+	}
+
+	for _, collector := range []prometheus.Collector{c.requests, c.latency, c.items, c.firstItem, c.inFlight} {
+		if err := reg.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
@@ -0,0 +1,168 @@
+package metrics_test
+
+import (
+	"context"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-football-data-go-grpc-client/metrics"
+	"github.com/statistico/statistico-football-data-go-grpc-client/statisticofootballdatatest"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"testing"
+)
+
+func TestCollector_Middleware(t *testing.T) {
+	t.Run("counts requests by method and error class", func(t *testing.T) {
+		t.Helper()
+
+		reg := prometheus.NewRegistry()
+		collector, err := metrics.NewCollector(reg)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		client := newTeamClient(t, collector.Middleware())
+		unavailable := newTeamClient(t, collector.Middleware(), statisticofootballdatatest.WithError(
+			statistico.TeamService_GetTeamByID_FullMethodName,
+			status.Error(codes.Unavailable, "unavailable"),
+		))
+
+		_, _ = client.ByID(context.Background(), 1)
+		_, _ = client.ByID(context.Background(), 1)
+		_, _ = client.ByID(context.Background(), 404)
+		_, _ = unavailable.ByID(context.Background(), 1)
+
+		expected := `
+# HELP statistico_football_data_requests_total Requests made to the data service by client method and error class.
+# TYPE statistico_football_data_requests_total counter
+statistico_football_data_requests_total{error_class="BadGateway",method="TeamClient.ByID"} 1
+statistico_football_data_requests_total{error_class="NotFound",method="TeamClient.ByID"} 1
+statistico_football_data_requests_total{error_class="none",method="TeamClient.ByID"} 2
+`
+
+		if err := testutil.GatherAndCompare(reg, strings.NewReader(expected), "statistico_football_data_requests_total"); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, testutil.CollectAndCount(reg, "statistico_football_data_request_duration_seconds"))
+	})
+
+	t.Run("records items and time to first item for streams", func(t *testing.T) {
+		t.Helper()
+
+		reg := prometheus.NewRegistry()
+		collector, err := metrics.NewCollector(reg, metrics.WithItemBuckets([]float64{1, 5}))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		client := newTeamClient(t, collector.Middleware())
+
+		_, err = client.BySeasonID(context.Background(), 16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		expected := `
+# HELP statistico_football_data_stream_items Items received per stream from the data service by client method.
+# TYPE statistico_football_data_stream_items histogram
+statistico_football_data_stream_items_bucket{method="TeamClient.BySeasonID",le="1"} 0
+statistico_football_data_stream_items_bucket{method="TeamClient.BySeasonID",le="5"} 1
+statistico_football_data_stream_items_bucket{method="TeamClient.BySeasonID",le="+Inf"} 1
+statistico_football_data_stream_items_sum{method="TeamClient.BySeasonID"} 3
+statistico_football_data_stream_items_count{method="TeamClient.BySeasonID"} 1
+`
+
+		if err := testutil.GatherAndCompare(reg, strings.NewReader(expected), "statistico_football_data_stream_items"); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, testutil.CollectAndCount(reg, "statistico_football_data_stream_first_item_seconds"))
+	})
+
+	t.Run("tracks requests in flight", func(t *testing.T) {
+		t.Helper()
+
+		reg := prometheus.NewRegistry()
+		collector, err := metrics.NewCollector(reg, metrics.WithNamespace("test"))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		var during float64
+
+		probe := func(next statisticofootballdata.Invoker) statisticofootballdata.Invoker {
+			return func(ctx context.Context, call *statisticofootballdata.Call) (any, error) {
+				gathered, _ := reg.Gather()
+
+				for _, mf := range gathered {
+					if mf.GetName() == "test_requests_in_flight" {
+						during = mf.GetMetric()[0].GetGauge().GetValue()
+					}
+				}
+
+				return next(ctx, call)
+			}
+		}
+
+		client := newTeamClient(t, statisticofootballdata.Chain(collector.Middleware(), probe))
+
+		_, err = client.ByID(context.Background(), 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		expected := `
+# HELP test_requests_in_flight Requests to the data service in progress by client method.
+# TYPE test_requests_in_flight gauge
+test_requests_in_flight{method="TeamClient.ByID"} 0
+`
+
+		if err := testutil.GatherAndCompare(reg, strings.NewReader(expected), "test_requests_in_flight"); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, float64(1), during)
+	})
+}
+
+func TestNewCollector(t *testing.T) {
+	t.Run("returns error if metrics are already registered", func(t *testing.T) {
+		t.Helper()
+
+		reg := prometheus.NewRegistry()
+
+		_, err := metrics.NewCollector(reg)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		_, err = metrics.NewCollector(reg)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+	})
+}
+
+// newTeamClient returns a TeamClient using mw over a statisticofootballdatatest.Server serving three
+// teams in season 16036.
+func newTeamClient(t *testing.T, mw statisticofootballdata.Middleware, opts ...statisticofootballdatatest.Option) statisticofootballdata.TeamClient {
+	srv := statisticofootballdatatest.NewServer(&statisticofootballdatatest.Dataset{
+		Teams:       []*statistico.Team{{Id: 1}, {Id: 2}, {Id: 3}},
+		SeasonTeams: map[uint64][]uint64{16036: {1, 2, 3}},
+	}, opts...)
+	t.Cleanup(srv.Close)
+
+	return statisticofootballdata.NewTeamClient(statistico.NewTeamServiceClient(srv.Conn()), statisticofootballdata.WithMiddleware(mw))
+}
@@ -0,0 +1,156 @@
+package statisticofootballdata
+
+import (
+	"context"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Call describes a single call made through a client wrapper method.
+type Call struct {
+	// Method is the wrapper method being called, e.g. MethodTeamByID.
+	Method string
+	// Request is the request message sent to the data service.
+	Request proto.Message
+	// Streaming reports whether the data service streams the response.
+	Streaming bool
+	// ResponseType is the type of the response message, or of each streamed message.
+	ResponseType protoreflect.MessageType
+
+	onRecv []func(m proto.Message)
+}
+
+// OnRecv registers fn to be called with each message received by a streaming call as it arrives.
+func (c *Call) OnRecv(fn func(m proto.Message)) {
+	c.onRecv = append(c.onRecv, fn)
+}
+
+func (c *Call) received(m proto.Message) {
+	for _, fn := range c.onRecv {
+		fn(m)
+	}
+}
+
+// Invoker performs a call. The response is a proto.Message for unary methods and a []proto.Message
+// for streaming methods, and errors are those returned by the wrapper methods such as ErrorNotFound.
+type Invoker func(ctx context.Context, call *Call) (any, error)
+
+// Middleware wraps an Invoker to add behaviour to every call made by a client.
+type Middleware func(next Invoker) Invoker
+
+// Chain composes middleware into one, with the first middleware outermost.
+func Chain(mw ...Middleware) Middleware {
+	return func(next Invoker) Invoker {
+		for i := len(mw) - 1; i >= 0; i-- {
+			next = mw[i](next)
+		}
+
+		return next
+	}
+}
+
+// Option configures a client.
+type Option func(o *options)
+
+type options struct {
+	middleware []Middleware
+}
+
+// WithMiddleware adds middleware applied to every call made by the client, with the first
+// middleware outermost.
+func WithMiddleware(mw ...Middleware) Option {
+	return func(o *options) {
+		o.middleware = append(o.middleware, mw...)
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+func typeOf[T proto.Message]() protoreflect.MessageType {
+	var zero T
+
+	return zero.ProtoReflect().Type()
+}
+
+// invokeUnary calls fn through the configured middleware.
+func invokeUnary[T proto.Message](ctx context.Context, o options, method string, req proto.Message, fn func(ctx context.Context) (T, error)) (T, error) {
+	if len(o.middleware) == 0 {
+		return fn(ctx)
+	}
+
+	call := &Call{Method: method, Request: req, ResponseType: typeOf[T]()}
+
+	res, err := Chain(o.middleware...)(func(ctx context.Context, _ *Call) (any, error) {
+		res, err := fn(ctx)
+
+		if !res.ProtoReflect().IsValid() {
+			return nil, err
+		}
+
+		return proto.Message(res), err
+	})(ctx, call)
+
+	m, _ := res.(T)
+
+	return m, err
+}
+
+// invokeStream calls fn through the configured middleware. fn must pass each message it receives
+// to the call's received method.
+func invokeStream[T proto.Message](ctx context.Context, o options, method string, req proto.Message, fn func(ctx context.Context, call *Call) ([]T, error)) ([]T, error) {
+	call := &Call{Method: method, Request: req, Streaming: true, ResponseType: typeOf[T]()}
+
+	if len(o.middleware) == 0 {
+		return fn(ctx, call)
+	}
+
+	res, err := Chain(o.middleware...)(func(ctx context.Context, call *Call) (any, error) {
+		res, err := fn(ctx, call)
+
+		return toMessages(res), err
+	})(ctx, call)
+
+	msgs, _ := res.([]proto.Message)
+
+	return fromMessages[T](msgs), err
+}
+
+// toMessages and fromMessages convert between the typed and untyped results of a streaming call,
+// preserving nil so wrapper methods return the same result with or without middleware.
+func toMessages[T proto.Message](res []T) []proto.Message {
+	if res == nil {
+		return nil
+	}
+
+	msgs := make([]proto.Message, len(res))
+
+	for i, m := range res {
+		msgs[i] = m
+	}
+
+	return msgs
+}
+
+func fromMessages[T proto.Message](msgs []proto.Message) []T {
+	if msgs == nil {
+		return nil
+	}
+
+	res := make([]T, 0, len(msgs))
+
+	for _, m := range msgs {
+		if t, ok := m.(T); ok {
+			res = append(res, t)
+		}
+	}
+
+	return res
+}
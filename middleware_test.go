@@ -0,0 +1,280 @@
+package statisticofootballdata_test
+
+import (
+	"context"
+	"errors"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"io"
+	"testing"
+)
+
+func TestWithMiddleware(t *testing.T) {
+	t.Run("passes unary call details and response through middleware in order", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+
+		order := []string{}
+		calls := []*statisticofootballdata.Call{}
+		responses := []any{}
+
+		record := func(name string) statisticofootballdata.Middleware {
+			return func(next statisticofootballdata.Invoker) statisticofootballdata.Invoker {
+				return func(ctx context.Context, call *statisticofootballdata.Call) (any, error) {
+					order = append(order, name)
+					calls = append(calls, call)
+
+					res, err := next(ctx, call)
+
+					responses = append(responses, res)
+
+					return res, err
+				}
+			}
+		}
+
+		client := statisticofootballdata.NewTeamClient(m, statisticofootballdata.WithMiddleware(record("first"), record("second")))
+
+		ctx := context.Background()
+
+		m.On("GetTeamByID", ctx, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{Id: 1}, nil)
+
+		team, err := client.ByID(ctx, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, uint64(1), team.GetId())
+		assert.Equal(t, []string{"first", "second"}, order)
+		assert.Equal(t, statisticofootballdata.MethodTeamByID, calls[0].Method)
+		assert.False(t, calls[0].Streaming)
+		assert.Equal(t, uint64(1), calls[0].Request.(*statistico.TeamRequest).GetTeamId())
+		assert.Equal(t, "statistico.Team", string(calls[0].ResponseType.Descriptor().FullName()))
+		assert.Equal(t, uint64(1), responses[0].(*statistico.Team).GetId())
+		m.AssertExpectations(t)
+	})
+
+	t.Run("passes each streamed message to OnRecv callbacks", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		stream := new(MockTeamStream)
+
+		received := []uint64{}
+		var response any
+
+		mw := func(next statisticofootballdata.Invoker) statisticofootballdata.Invoker {
+			return func(ctx context.Context, call *statisticofootballdata.Call) (any, error) {
+				assert.True(t, call.Streaming)
+				assert.Equal(t, statisticofootballdata.MethodTeamBySeasonID, call.Method)
+
+				call.OnRecv(func(m proto.Message) {
+					received = append(received, m.(*statistico.Team).GetId())
+				})
+
+				res, err := next(ctx, call)
+
+				response = res
+
+				return res, err
+			}
+		}
+
+		client := statisticofootballdata.NewTeamClient(m, statisticofootballdata.WithMiddleware(mw))
+
+		ctx := context.Background()
+
+		m.On("GetTeamsBySeasonId", ctx, &statistico.SeasonTeamsRequest{SeasonId: 16036}, []grpc.CallOption(nil)).Return(stream, nil)
+		stream.On("Recv").Once().Return(&statistico.Team{Id: 1}, nil)
+		stream.On("Recv").Once().Return(&statistico.Team{Id: 2}, nil)
+		stream.On("Recv").Once().Return(&statistico.Team{}, io.EOF)
+
+		teams, err := client.BySeasonID(ctx, 16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(teams))
+		assert.Equal(t, []uint64{1, 2}, received)
+		assert.Equal(t, 2, len(response.([]proto.Message)))
+		m.AssertExpectations(t)
+		stream.AssertExpectations(t)
+	})
+
+	t.Run("passes mapped errors to middleware", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoPlayerClient)
+
+		var mwErr error
+
+		mw := func(next statisticofootballdata.Invoker) statisticofootballdata.Invoker {
+			return func(ctx context.Context, call *statisticofootballdata.Call) (any, error) {
+				res, err := next(ctx, call)
+
+				mwErr = err
+
+				return res, err
+			}
+		}
+
+		client := statisticofootballdata.NewPlayerClient(m, statisticofootballdata.WithMiddleware(mw))
+
+		ctx := context.Background()
+
+		e := status.Error(codes.NotFound, "not found")
+
+		m.On("GetPlayerByID", ctx, &statistico.PlayerRequest{PlayerId: 5}, []grpc.CallOption(nil)).Return(&statistico.Player{}, e)
+
+		player, err := client.ByID(ctx, 5)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Nil(t, player)
+		assert.IsType(t, statisticofootballdata.ErrorNotFound{}, mwErr)
+		assert.Equal(t, "resource with ID '5' does not exist. Error: rpc error: code = NotFound desc = not found", err.Error())
+		m.AssertExpectations(t)
+	})
+
+	t.Run("passes seasons by team as a unary call", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoSeasonClient)
+
+		var call *statisticofootballdata.Call
+
+		mw := func(next statisticofootballdata.Invoker) statisticofootballdata.Invoker {
+			return func(ctx context.Context, c *statisticofootballdata.Call) (any, error) {
+				call = c
+
+				return next(ctx, c)
+			}
+		}
+
+		client := statisticofootballdata.NewSeasonClient(m, statisticofootballdata.WithMiddleware(mw))
+
+		ctx := context.Background()
+		req := statistico.TeamSeasonsRequest{TeamId: 1, Sort: &wrapperspb.StringValue{Value: "name_desc"}}
+
+		m.On("GetSeasonsForTeam", ctx, &req, []grpc.CallOption(nil)).
+			Return(&statistico.TeamSeasonsResponse{Seasons: []*statistico.Season{newProtoSeason()}}, nil)
+
+		seasons, err := client.ByTeamID(ctx, 1, "name_desc")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(seasons))
+		assert.False(t, call.Streaming)
+		assert.Equal(t, "statistico.TeamSeasonsResponse", string(call.ResponseType.Descriptor().FullName()))
+		m.AssertExpectations(t)
+	})
+
+	t.Run("returns the same result on error as without middleware", func(t *testing.T) {
+		t.Helper()
+
+		mw := func(next statisticofootballdata.Invoker) statisticofootballdata.Invoker {
+			return next
+		}
+
+		for _, opts := range [][]statisticofootballdata.Option{nil, {statisticofootballdata.WithMiddleware(mw)}} {
+			tc := new(MockProtoTeamClient)
+			sc := new(MockProtoSeasonClient)
+
+			ctx := context.Background()
+
+			tc.On("GetTeamsBySeasonId", ctx, &statistico.SeasonTeamsRequest{SeasonId: 1}, []grpc.CallOption(nil)).
+				Return(new(MockTeamStream), errors.New("connection reset"))
+			sc.On("GetSeasonsForTeam", ctx, mock.Anything, []grpc.CallOption(nil)).
+				Return(&statistico.TeamSeasonsResponse{}, status.Error(codes.Unavailable, "unavailable"))
+
+			teams, err := statisticofootballdata.NewTeamClient(tc, opts...).BySeasonID(ctx, 1)
+
+			assert.Error(t, err)
+			assert.Nil(t, teams)
+
+			seasons, err := statisticofootballdata.NewSeasonClient(sc, opts...).ByTeamID(ctx, 1, "name_desc")
+
+			assert.Error(t, err)
+			assert.Equal(t, []*statistico.Season{}, seasons)
+		}
+	})
+
+	t.Run("allows middleware to return a response without calling the data service", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockFixtureProtoClient)
+
+		mw := func(next statisticofootballdata.Invoker) statisticofootballdata.Invoker {
+			return func(ctx context.Context, call *statisticofootballdata.Call) (any, error) {
+				if call.Streaming {
+					return []proto.Message{&statistico.Fixture{Id: 3}}, nil
+				}
+
+				return &statistico.Fixture{Id: 4}, nil
+			}
+		}
+
+		client := statisticofootballdata.NewFixtureClient(m, statisticofootballdata.WithMiddleware(mw))
+
+		fixtures, err := client.Search(context.Background(), &statistico.FixtureSearchRequest{})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		fixture, err := client.ByID(context.Background(), 4)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, int64(3), fixtures[0].GetId())
+		assert.Equal(t, int64(4), fixture.GetId())
+		m.AssertNotCalled(t, "Search", mock.Anything, mock.Anything, mock.Anything)
+		m.AssertNotCalled(t, "FixtureByID", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestChain(t *testing.T) {
+	t.Run("composes middleware with the first outermost", func(t *testing.T) {
+		t.Helper()
+
+		order := []string{}
+
+		record := func(name string) statisticofootballdata.Middleware {
+			return func(next statisticofootballdata.Invoker) statisticofootballdata.Invoker {
+				return func(ctx context.Context, call *statisticofootballdata.Call) (any, error) {
+					order = append(order, name)
+					return next(ctx, call)
+				}
+			}
+		}
+
+		invoker := statisticofootballdata.Chain(record("a"), record("b"), record("c"))(func(ctx context.Context, call *statisticofootballdata.Call) (any, error) {
+			order = append(order, "invoker")
+			return nil, nil
+		})
+
+		_, err := invoker(context.Background(), &statisticofootballdata.Call{})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []string{"a", "b", "c", "invoker"}, order)
+	})
+}
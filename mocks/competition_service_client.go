@@ -0,0 +1,114 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	statistico "github.com/statistico/statistico-proto/go"
+)
+
+// CompetitionServiceClient is an autogenerated mock type for the CompetitionServiceClient type
+type CompetitionServiceClient struct {
+	mock.Mock
+}
+
+type CompetitionServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CompetitionServiceClient) EXPECT() *CompetitionServiceClient_Expecter {
+	return &CompetitionServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// ListCompetitions provides a mock function with given fields: ctx, in, opts
+func (_m *CompetitionServiceClient) ListCompetitions(ctx context.Context, in *statistico.CompetitionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Competition], error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCompetitions")
+	}
+
+	var r0 grpc.ServerStreamingClient[statistico.Competition]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.CompetitionRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Competition], error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.CompetitionRequest, ...grpc.CallOption) grpc.ServerStreamingClient[statistico.Competition]); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(grpc.ServerStreamingClient[statistico.Competition])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *statistico.CompetitionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompetitionServiceClient_ListCompetitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCompetitions'
+type CompetitionServiceClient_ListCompetitions_Call struct {
+	*mock.Call
+}
+
+// ListCompetitions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *statistico.CompetitionRequest
+//   - opts ...grpc.CallOption
+func (_e *CompetitionServiceClient_Expecter) ListCompetitions(ctx interface{}, in interface{}, opts ...interface{}) *CompetitionServiceClient_ListCompetitions_Call {
+	return &CompetitionServiceClient_ListCompetitions_Call{Call: _e.mock.On("ListCompetitions",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *CompetitionServiceClient_ListCompetitions_Call) Run(run func(ctx context.Context, in *statistico.CompetitionRequest, opts ...grpc.CallOption)) *CompetitionServiceClient_ListCompetitions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*statistico.CompetitionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *CompetitionServiceClient_ListCompetitions_Call) Return(_a0 grpc.ServerStreamingClient[statistico.Competition], _a1 error) *CompetitionServiceClient_ListCompetitions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CompetitionServiceClient_ListCompetitions_Call) RunAndReturn(run func(context.Context, *statistico.CompetitionRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Competition], error)) *CompetitionServiceClient_ListCompetitions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCompetitionServiceClient creates a new instance of CompetitionServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCompetitionServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CompetitionServiceClient {
+	mock := &CompetitionServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,9 @@
+// Package mocks provides testify mocks of the client interfaces and the statistico proto service
+// clients they wrap. The mocks are generated by mockery from .mockery.yaml, so are regenerated from the
+// interfaces by running go generate after a client interface changes or statistico-proto is upgraded. CI
+// fails if the committed mocks differ from those generated. Each mock has a constructor, such as
+// NewTeamClient, asserting its expectations once the test ends, and an EXPECT method for setting typed
+// expectations. Stream is written by hand as mockery cannot construct a stream returning a list of items.
+package mocks
+
+//go:generate go run github.com/vektra/mockery/v2@v2.53.7
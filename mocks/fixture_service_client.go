@@ -0,0 +1,262 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	statistico "github.com/statistico/statistico-proto/go"
+)
+
+// FixtureServiceClient is an autogenerated mock type for the FixtureServiceClient type
+type FixtureServiceClient struct {
+	mock.Mock
+}
+
+type FixtureServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FixtureServiceClient) EXPECT() *FixtureServiceClient_Expecter {
+	return &FixtureServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// FixtureByID provides a mock function with given fields: ctx, in, opts
+func (_m *FixtureServiceClient) FixtureByID(ctx context.Context, in *statistico.FixtureRequest, opts ...grpc.CallOption) (*statistico.Fixture, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FixtureByID")
+	}
+
+	var r0 *statistico.Fixture
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.FixtureRequest, ...grpc.CallOption) (*statistico.Fixture, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.FixtureRequest, ...grpc.CallOption) *statistico.Fixture); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*statistico.Fixture)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *statistico.FixtureRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FixtureServiceClient_FixtureByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FixtureByID'
+type FixtureServiceClient_FixtureByID_Call struct {
+	*mock.Call
+}
+
+// FixtureByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *statistico.FixtureRequest
+//   - opts ...grpc.CallOption
+func (_e *FixtureServiceClient_Expecter) FixtureByID(ctx interface{}, in interface{}, opts ...interface{}) *FixtureServiceClient_FixtureByID_Call {
+	return &FixtureServiceClient_FixtureByID_Call{Call: _e.mock.On("FixtureByID",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *FixtureServiceClient_FixtureByID_Call) Run(run func(ctx context.Context, in *statistico.FixtureRequest, opts ...grpc.CallOption)) *FixtureServiceClient_FixtureByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*statistico.FixtureRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *FixtureServiceClient_FixtureByID_Call) Return(_a0 *statistico.Fixture, _a1 error) *FixtureServiceClient_FixtureByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FixtureServiceClient_FixtureByID_Call) RunAndReturn(run func(context.Context, *statistico.FixtureRequest, ...grpc.CallOption) (*statistico.Fixture, error)) *FixtureServiceClient_FixtureByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSeasonFixtures provides a mock function with given fields: ctx, in, opts
+func (_m *FixtureServiceClient) ListSeasonFixtures(ctx context.Context, in *statistico.SeasonFixtureRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Fixture], error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSeasonFixtures")
+	}
+
+	var r0 grpc.ServerStreamingClient[statistico.Fixture]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.SeasonFixtureRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Fixture], error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.SeasonFixtureRequest, ...grpc.CallOption) grpc.ServerStreamingClient[statistico.Fixture]); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(grpc.ServerStreamingClient[statistico.Fixture])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *statistico.SeasonFixtureRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FixtureServiceClient_ListSeasonFixtures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSeasonFixtures'
+type FixtureServiceClient_ListSeasonFixtures_Call struct {
+	*mock.Call
+}
+
+// ListSeasonFixtures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *statistico.SeasonFixtureRequest
+//   - opts ...grpc.CallOption
+func (_e *FixtureServiceClient_Expecter) ListSeasonFixtures(ctx interface{}, in interface{}, opts ...interface{}) *FixtureServiceClient_ListSeasonFixtures_Call {
+	return &FixtureServiceClient_ListSeasonFixtures_Call{Call: _e.mock.On("ListSeasonFixtures",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *FixtureServiceClient_ListSeasonFixtures_Call) Run(run func(ctx context.Context, in *statistico.SeasonFixtureRequest, opts ...grpc.CallOption)) *FixtureServiceClient_ListSeasonFixtures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*statistico.SeasonFixtureRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *FixtureServiceClient_ListSeasonFixtures_Call) Return(_a0 grpc.ServerStreamingClient[statistico.Fixture], _a1 error) *FixtureServiceClient_ListSeasonFixtures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FixtureServiceClient_ListSeasonFixtures_Call) RunAndReturn(run func(context.Context, *statistico.SeasonFixtureRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Fixture], error)) *FixtureServiceClient_ListSeasonFixtures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function with given fields: ctx, in, opts
+func (_m *FixtureServiceClient) Search(ctx context.Context, in *statistico.FixtureSearchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Fixture], error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 grpc.ServerStreamingClient[statistico.Fixture]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.FixtureSearchRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Fixture], error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.FixtureSearchRequest, ...grpc.CallOption) grpc.ServerStreamingClient[statistico.Fixture]); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(grpc.ServerStreamingClient[statistico.Fixture])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *statistico.FixtureSearchRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FixtureServiceClient_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type FixtureServiceClient_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *statistico.FixtureSearchRequest
+//   - opts ...grpc.CallOption
+func (_e *FixtureServiceClient_Expecter) Search(ctx interface{}, in interface{}, opts ...interface{}) *FixtureServiceClient_Search_Call {
+	return &FixtureServiceClient_Search_Call{Call: _e.mock.On("Search",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *FixtureServiceClient_Search_Call) Run(run func(ctx context.Context, in *statistico.FixtureSearchRequest, opts ...grpc.CallOption)) *FixtureServiceClient_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*statistico.FixtureSearchRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *FixtureServiceClient_Search_Call) Return(_a0 grpc.ServerStreamingClient[statistico.Fixture], _a1 error) *FixtureServiceClient_Search_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FixtureServiceClient_Search_Call) RunAndReturn(run func(context.Context, *statistico.FixtureSearchRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Fixture], error)) *FixtureServiceClient_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFixtureServiceClient creates a new instance of FixtureServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFixtureServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FixtureServiceClient {
+	mock := &FixtureServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
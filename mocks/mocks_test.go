@@ -0,0 +1,145 @@
+package mocks_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-football-data-go-grpc-client/mocks"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+)
+
+func TestMocks(t *testing.T) {
+	t.Run("mocks have no methods missing from their interfaces", func(t *testing.T) {
+		t.Helper()
+
+		pairs := map[any]reflect.Type{
+			&mocks.CompetitionClient{}:        reflect.TypeFor[statisticofootballdata.CompetitionClient](),
+			&mocks.EventClient{}:              reflect.TypeFor[statisticofootballdata.EventClient](),
+			&mocks.FixtureClient{}:            reflect.TypeFor[statisticofootballdata.FixtureClient](),
+			&mocks.PlayerClient{}:             reflect.TypeFor[statisticofootballdata.PlayerClient](),
+			&mocks.PlayerStatsClient{}:        reflect.TypeFor[statisticofootballdata.PlayerStatsClient](),
+			&mocks.SeasonClient{}:             reflect.TypeFor[statisticofootballdata.SeasonClient](),
+			&mocks.TeamClient{}:               reflect.TypeFor[statisticofootballdata.TeamClient](),
+			&mocks.TeamStatClient{}:           reflect.TypeFor[statisticofootballdata.TeamStatClient](),
+			&mocks.CompetitionServiceClient{}: reflect.TypeFor[statistico.CompetitionServiceClient](),
+			&mocks.EventServiceClient{}:       reflect.TypeFor[statistico.EventServiceClient](),
+			&mocks.FixtureServiceClient{}:     reflect.TypeFor[statistico.FixtureServiceClient](),
+			&mocks.PlayerServiceClient{}:      reflect.TypeFor[statistico.PlayerServiceClient](),
+			&mocks.PlayerStatsServiceClient{}: reflect.TypeFor[statistico.PlayerStatsServiceClient](),
+			&mocks.SeasonServiceClient{}:      reflect.TypeFor[statistico.SeasonServiceClient](),
+			&mocks.TeamServiceClient{}:        reflect.TypeFor[statistico.TeamServiceClient](),
+			&mocks.TeamStatsServiceClient{}:   reflect.TypeFor[statistico.TeamStatsServiceClient](),
+		}
+
+		embedded := reflect.TypeFor[*mock.Mock]()
+
+		for m, iface := range pairs {
+			typ := reflect.TypeOf(m)
+
+			for i := 0; i < typ.NumMethod(); i++ {
+				name := typ.Method(i).Name
+
+				if _, ok := embedded.MethodByName(name); ok || name == "EXPECT" {
+					continue
+				}
+
+				_, ok := iface.MethodByName(name)
+
+				assert.True(t, ok, "%s.%s is not a method of %s", typ.Elem().Name(), name, iface)
+			}
+		}
+	})
+
+	t.Run("wrapper mocks return configured values", func(t *testing.T) {
+		t.Helper()
+
+		m := new(mocks.TeamClient)
+		ctx := context.Background()
+
+		m.On("ByID", ctx, uint64(1)).Return(&statistico.Team{Id: 1, Name: "West Ham United"}, nil)
+		m.On("ByID", ctx, uint64(404)).Return(nil, statisticofootballdata.ErrorNotFound{ID: 404})
+
+		team, err := m.ByID(ctx, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "West Ham United", team.GetName())
+
+		team, err = m.ByID(ctx, 404)
+
+		assert.Nil(t, team)
+		assert.IsType(t, statisticofootballdata.ErrorNotFound{}, err)
+		m.AssertExpectations(t)
+	})
+
+	t.Run("wrapper mocks support typed expectations", func(t *testing.T) {
+		t.Helper()
+
+		m := mocks.NewFixtureClient(t)
+		ctx := context.Background()
+
+		m.EXPECT().ByID(ctx, uint64(192)).Return(&statistico.Fixture{Id: 192}, nil).Once()
+
+		fixture, err := m.ByID(ctx, 192)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, int64(192), fixture.GetId())
+	})
+
+	t.Run("proto client mocks and streams drive the real clients", func(t *testing.T) {
+		t.Helper()
+
+		m := new(mocks.FixtureServiceClient)
+		client := statisticofootballdata.NewFixtureClient(m)
+
+		ctx := context.Background()
+		req := &statistico.FixtureSearchRequest{SeasonIds: []uint64{16036}}
+
+		stream := mocks.NewStream([]*statistico.Fixture{{Id: 192}, {Id: 193}}, nil)
+
+		m.On("Search", ctx, req).Return(stream, nil)
+
+		fixtures, err := client.Search(ctx, req)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(fixtures))
+		m.AssertExpectations(t)
+		stream.AssertExpectations(t)
+	})
+
+	t.Run("streams return errors after items", func(t *testing.T) {
+		t.Helper()
+
+		m := new(mocks.TeamServiceClient)
+		client := statisticofootballdata.NewTeamClient(m)
+
+		ctx := context.Background()
+
+		stream := mocks.NewStream([]*statistico.Team{{Id: 1}}, status.Error(codes.Internal, "oh damn"))
+
+		m.On("GetTeamsBySeasonId", ctx, &statistico.SeasonTeamsRequest{SeasonId: 16036}).Return(stream, nil)
+
+		teams, err := client.BySeasonID(ctx, 16036)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, 1, len(teams))
+		assert.IsType(t, statisticofootballdata.ErrorExternalServer{}, err)
+		stream.AssertExpectations(t)
+	})
+}
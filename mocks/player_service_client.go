@@ -0,0 +1,114 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	statistico "github.com/statistico/statistico-proto/go"
+)
+
+// PlayerServiceClient is an autogenerated mock type for the PlayerServiceClient type
+type PlayerServiceClient struct {
+	mock.Mock
+}
+
+type PlayerServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PlayerServiceClient) EXPECT() *PlayerServiceClient_Expecter {
+	return &PlayerServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// GetPlayerByID provides a mock function with given fields: ctx, in, opts
+func (_m *PlayerServiceClient) GetPlayerByID(ctx context.Context, in *statistico.PlayerRequest, opts ...grpc.CallOption) (*statistico.Player, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlayerByID")
+	}
+
+	var r0 *statistico.Player
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.PlayerRequest, ...grpc.CallOption) (*statistico.Player, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.PlayerRequest, ...grpc.CallOption) *statistico.Player); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*statistico.Player)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *statistico.PlayerRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PlayerServiceClient_GetPlayerByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPlayerByID'
+type PlayerServiceClient_GetPlayerByID_Call struct {
+	*mock.Call
+}
+
+// GetPlayerByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *statistico.PlayerRequest
+//   - opts ...grpc.CallOption
+func (_e *PlayerServiceClient_Expecter) GetPlayerByID(ctx interface{}, in interface{}, opts ...interface{}) *PlayerServiceClient_GetPlayerByID_Call {
+	return &PlayerServiceClient_GetPlayerByID_Call{Call: _e.mock.On("GetPlayerByID",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *PlayerServiceClient_GetPlayerByID_Call) Run(run func(ctx context.Context, in *statistico.PlayerRequest, opts ...grpc.CallOption)) *PlayerServiceClient_GetPlayerByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*statistico.PlayerRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *PlayerServiceClient_GetPlayerByID_Call) Return(_a0 *statistico.Player, _a1 error) *PlayerServiceClient_GetPlayerByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PlayerServiceClient_GetPlayerByID_Call) RunAndReturn(run func(context.Context, *statistico.PlayerRequest, ...grpc.CallOption) (*statistico.Player, error)) *PlayerServiceClient_GetPlayerByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPlayerServiceClient creates a new instance of PlayerServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPlayerServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PlayerServiceClient {
+	mock := &PlayerServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,262 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	statistico "github.com/statistico/statistico-proto/go"
+)
+
+// PlayerStatsServiceClient is an autogenerated mock type for the PlayerStatsServiceClient type
+type PlayerStatsServiceClient struct {
+	mock.Mock
+}
+
+type PlayerStatsServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PlayerStatsServiceClient) EXPECT() *PlayerStatsServiceClient_Expecter {
+	return &PlayerStatsServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// GetLineUpForFixture provides a mock function with given fields: ctx, in, opts
+func (_m *PlayerStatsServiceClient) GetLineUpForFixture(ctx context.Context, in *statistico.FixtureRequest, opts ...grpc.CallOption) (*statistico.LineupResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLineUpForFixture")
+	}
+
+	var r0 *statistico.LineupResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.FixtureRequest, ...grpc.CallOption) (*statistico.LineupResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.FixtureRequest, ...grpc.CallOption) *statistico.LineupResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*statistico.LineupResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *statistico.FixtureRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PlayerStatsServiceClient_GetLineUpForFixture_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLineUpForFixture'
+type PlayerStatsServiceClient_GetLineUpForFixture_Call struct {
+	*mock.Call
+}
+
+// GetLineUpForFixture is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *statistico.FixtureRequest
+//   - opts ...grpc.CallOption
+func (_e *PlayerStatsServiceClient_Expecter) GetLineUpForFixture(ctx interface{}, in interface{}, opts ...interface{}) *PlayerStatsServiceClient_GetLineUpForFixture_Call {
+	return &PlayerStatsServiceClient_GetLineUpForFixture_Call{Call: _e.mock.On("GetLineUpForFixture",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *PlayerStatsServiceClient_GetLineUpForFixture_Call) Run(run func(ctx context.Context, in *statistico.FixtureRequest, opts ...grpc.CallOption)) *PlayerStatsServiceClient_GetLineUpForFixture_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*statistico.FixtureRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *PlayerStatsServiceClient_GetLineUpForFixture_Call) Return(_a0 *statistico.LineupResponse, _a1 error) *PlayerStatsServiceClient_GetLineUpForFixture_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PlayerStatsServiceClient_GetLineUpForFixture_Call) RunAndReturn(run func(context.Context, *statistico.FixtureRequest, ...grpc.CallOption) (*statistico.LineupResponse, error)) *PlayerStatsServiceClient_GetLineUpForFixture_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPlayerStatsForFixture provides a mock function with given fields: ctx, in, opts
+func (_m *PlayerStatsServiceClient) GetPlayerStatsForFixture(ctx context.Context, in *statistico.FixtureRequest, opts ...grpc.CallOption) (*statistico.PlayerStatsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlayerStatsForFixture")
+	}
+
+	var r0 *statistico.PlayerStatsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.FixtureRequest, ...grpc.CallOption) (*statistico.PlayerStatsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.FixtureRequest, ...grpc.CallOption) *statistico.PlayerStatsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*statistico.PlayerStatsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *statistico.FixtureRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PlayerStatsServiceClient_GetPlayerStatsForFixture_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPlayerStatsForFixture'
+type PlayerStatsServiceClient_GetPlayerStatsForFixture_Call struct {
+	*mock.Call
+}
+
+// GetPlayerStatsForFixture is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *statistico.FixtureRequest
+//   - opts ...grpc.CallOption
+func (_e *PlayerStatsServiceClient_Expecter) GetPlayerStatsForFixture(ctx interface{}, in interface{}, opts ...interface{}) *PlayerStatsServiceClient_GetPlayerStatsForFixture_Call {
+	return &PlayerStatsServiceClient_GetPlayerStatsForFixture_Call{Call: _e.mock.On("GetPlayerStatsForFixture",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *PlayerStatsServiceClient_GetPlayerStatsForFixture_Call) Run(run func(ctx context.Context, in *statistico.FixtureRequest, opts ...grpc.CallOption)) *PlayerStatsServiceClient_GetPlayerStatsForFixture_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*statistico.FixtureRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *PlayerStatsServiceClient_GetPlayerStatsForFixture_Call) Return(_a0 *statistico.PlayerStatsResponse, _a1 error) *PlayerStatsServiceClient_GetPlayerStatsForFixture_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PlayerStatsServiceClient_GetPlayerStatsForFixture_Call) RunAndReturn(run func(context.Context, *statistico.FixtureRequest, ...grpc.CallOption) (*statistico.PlayerStatsResponse, error)) *PlayerStatsServiceClient_GetPlayerStatsForFixture_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamSeasonPlayerStats provides a mock function with given fields: ctx, in, opts
+func (_m *PlayerStatsServiceClient) GetTeamSeasonPlayerStats(ctx context.Context, in *statistico.TeamSeasonPlayStatsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.PlayerStats], error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamSeasonPlayerStats")
+	}
+
+	var r0 grpc.ServerStreamingClient[statistico.PlayerStats]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.TeamSeasonPlayStatsRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.PlayerStats], error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.TeamSeasonPlayStatsRequest, ...grpc.CallOption) grpc.ServerStreamingClient[statistico.PlayerStats]); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(grpc.ServerStreamingClient[statistico.PlayerStats])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *statistico.TeamSeasonPlayStatsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PlayerStatsServiceClient_GetTeamSeasonPlayerStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamSeasonPlayerStats'
+type PlayerStatsServiceClient_GetTeamSeasonPlayerStats_Call struct {
+	*mock.Call
+}
+
+// GetTeamSeasonPlayerStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *statistico.TeamSeasonPlayStatsRequest
+//   - opts ...grpc.CallOption
+func (_e *PlayerStatsServiceClient_Expecter) GetTeamSeasonPlayerStats(ctx interface{}, in interface{}, opts ...interface{}) *PlayerStatsServiceClient_GetTeamSeasonPlayerStats_Call {
+	return &PlayerStatsServiceClient_GetTeamSeasonPlayerStats_Call{Call: _e.mock.On("GetTeamSeasonPlayerStats",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *PlayerStatsServiceClient_GetTeamSeasonPlayerStats_Call) Run(run func(ctx context.Context, in *statistico.TeamSeasonPlayStatsRequest, opts ...grpc.CallOption)) *PlayerStatsServiceClient_GetTeamSeasonPlayerStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*statistico.TeamSeasonPlayStatsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *PlayerStatsServiceClient_GetTeamSeasonPlayerStats_Call) Return(_a0 grpc.ServerStreamingClient[statistico.PlayerStats], _a1 error) *PlayerStatsServiceClient_GetTeamSeasonPlayerStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PlayerStatsServiceClient_GetTeamSeasonPlayerStats_Call) RunAndReturn(run func(context.Context, *statistico.TeamSeasonPlayStatsRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.PlayerStats], error)) *PlayerStatsServiceClient_GetTeamSeasonPlayerStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPlayerStatsServiceClient creates a new instance of PlayerStatsServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPlayerStatsServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PlayerStatsServiceClient {
+	mock := &PlayerStatsServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
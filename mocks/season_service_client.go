@@ -0,0 +1,188 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	statistico "github.com/statistico/statistico-proto/go"
+)
+
+// SeasonServiceClient is an autogenerated mock type for the SeasonServiceClient type
+type SeasonServiceClient struct {
+	mock.Mock
+}
+
+type SeasonServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SeasonServiceClient) EXPECT() *SeasonServiceClient_Expecter {
+	return &SeasonServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// GetSeasonsForCompetition provides a mock function with given fields: ctx, in, opts
+func (_m *SeasonServiceClient) GetSeasonsForCompetition(ctx context.Context, in *statistico.SeasonCompetitionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Season], error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeasonsForCompetition")
+	}
+
+	var r0 grpc.ServerStreamingClient[statistico.Season]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.SeasonCompetitionRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Season], error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.SeasonCompetitionRequest, ...grpc.CallOption) grpc.ServerStreamingClient[statistico.Season]); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(grpc.ServerStreamingClient[statistico.Season])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *statistico.SeasonCompetitionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SeasonServiceClient_GetSeasonsForCompetition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeasonsForCompetition'
+type SeasonServiceClient_GetSeasonsForCompetition_Call struct {
+	*mock.Call
+}
+
+// GetSeasonsForCompetition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *statistico.SeasonCompetitionRequest
+//   - opts ...grpc.CallOption
+func (_e *SeasonServiceClient_Expecter) GetSeasonsForCompetition(ctx interface{}, in interface{}, opts ...interface{}) *SeasonServiceClient_GetSeasonsForCompetition_Call {
+	return &SeasonServiceClient_GetSeasonsForCompetition_Call{Call: _e.mock.On("GetSeasonsForCompetition",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SeasonServiceClient_GetSeasonsForCompetition_Call) Run(run func(ctx context.Context, in *statistico.SeasonCompetitionRequest, opts ...grpc.CallOption)) *SeasonServiceClient_GetSeasonsForCompetition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*statistico.SeasonCompetitionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SeasonServiceClient_GetSeasonsForCompetition_Call) Return(_a0 grpc.ServerStreamingClient[statistico.Season], _a1 error) *SeasonServiceClient_GetSeasonsForCompetition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SeasonServiceClient_GetSeasonsForCompetition_Call) RunAndReturn(run func(context.Context, *statistico.SeasonCompetitionRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Season], error)) *SeasonServiceClient_GetSeasonsForCompetition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeasonsForTeam provides a mock function with given fields: ctx, in, opts
+func (_m *SeasonServiceClient) GetSeasonsForTeam(ctx context.Context, in *statistico.TeamSeasonsRequest, opts ...grpc.CallOption) (*statistico.TeamSeasonsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeasonsForTeam")
+	}
+
+	var r0 *statistico.TeamSeasonsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.TeamSeasonsRequest, ...grpc.CallOption) (*statistico.TeamSeasonsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.TeamSeasonsRequest, ...grpc.CallOption) *statistico.TeamSeasonsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*statistico.TeamSeasonsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *statistico.TeamSeasonsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SeasonServiceClient_GetSeasonsForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeasonsForTeam'
+type SeasonServiceClient_GetSeasonsForTeam_Call struct {
+	*mock.Call
+}
+
+// GetSeasonsForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *statistico.TeamSeasonsRequest
+//   - opts ...grpc.CallOption
+func (_e *SeasonServiceClient_Expecter) GetSeasonsForTeam(ctx interface{}, in interface{}, opts ...interface{}) *SeasonServiceClient_GetSeasonsForTeam_Call {
+	return &SeasonServiceClient_GetSeasonsForTeam_Call{Call: _e.mock.On("GetSeasonsForTeam",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SeasonServiceClient_GetSeasonsForTeam_Call) Run(run func(ctx context.Context, in *statistico.TeamSeasonsRequest, opts ...grpc.CallOption)) *SeasonServiceClient_GetSeasonsForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*statistico.TeamSeasonsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SeasonServiceClient_GetSeasonsForTeam_Call) Return(_a0 *statistico.TeamSeasonsResponse, _a1 error) *SeasonServiceClient_GetSeasonsForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SeasonServiceClient_GetSeasonsForTeam_Call) RunAndReturn(run func(context.Context, *statistico.TeamSeasonsRequest, ...grpc.CallOption) (*statistico.TeamSeasonsResponse, error)) *SeasonServiceClient_GetSeasonsForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSeasonServiceClient creates a new instance of SeasonServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSeasonServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SeasonServiceClient {
+	mock := &SeasonServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,39 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"io"
+)
+
+var _ grpc.ServerStreamingClient[struct{}] = (*Stream[struct{}])(nil)
+
+// Stream is a mock server streaming client, such as a statistico.FixtureService_SearchClient. Only Recv is
+// mocked.
+type Stream[T any] struct {
+	mock.Mock
+	grpc.ClientStream
+}
+
+// NewStream returns a Stream receiving items followed by err, or io.EOF if err is nil.
+func NewStream[T any](items []*T, err error) *Stream[T] {
+	s := &Stream[T]{}
+
+	for _, item := range items {
+		s.On("Recv").Once().Return(item, nil)
+	}
+
+	if err == nil {
+		err = io.EOF
+	}
+
+	s.On("Recv").Once().Return(nil, err)
+
+	return s
+}
+
+func (s *Stream[T]) Recv() (*T, error) {
+	args := s.Called()
+	item, _ := args.Get(0).(*T)
+	return item, args.Error(1)
+}
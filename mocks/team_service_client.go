@@ -0,0 +1,262 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	statistico "github.com/statistico/statistico-proto/go"
+)
+
+// TeamServiceClient is an autogenerated mock type for the TeamServiceClient type
+type TeamServiceClient struct {
+	mock.Mock
+}
+
+type TeamServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TeamServiceClient) EXPECT() *TeamServiceClient_Expecter {
+	return &TeamServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// GetTeamByID provides a mock function with given fields: ctx, in, opts
+func (_m *TeamServiceClient) GetTeamByID(ctx context.Context, in *statistico.TeamRequest, opts ...grpc.CallOption) (*statistico.Team, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamByID")
+	}
+
+	var r0 *statistico.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.TeamRequest, ...grpc.CallOption) (*statistico.Team, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.TeamRequest, ...grpc.CallOption) *statistico.Team); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*statistico.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *statistico.TeamRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamServiceClient_GetTeamByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamByID'
+type TeamServiceClient_GetTeamByID_Call struct {
+	*mock.Call
+}
+
+// GetTeamByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *statistico.TeamRequest
+//   - opts ...grpc.CallOption
+func (_e *TeamServiceClient_Expecter) GetTeamByID(ctx interface{}, in interface{}, opts ...interface{}) *TeamServiceClient_GetTeamByID_Call {
+	return &TeamServiceClient_GetTeamByID_Call{Call: _e.mock.On("GetTeamByID",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TeamServiceClient_GetTeamByID_Call) Run(run func(ctx context.Context, in *statistico.TeamRequest, opts ...grpc.CallOption)) *TeamServiceClient_GetTeamByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*statistico.TeamRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TeamServiceClient_GetTeamByID_Call) Return(_a0 *statistico.Team, _a1 error) *TeamServiceClient_GetTeamByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamServiceClient_GetTeamByID_Call) RunAndReturn(run func(context.Context, *statistico.TeamRequest, ...grpc.CallOption) (*statistico.Team, error)) *TeamServiceClient_GetTeamByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamsByCompetitionId provides a mock function with given fields: ctx, in, opts
+func (_m *TeamServiceClient) GetTeamsByCompetitionId(ctx context.Context, in *statistico.CompetitionTeamsRequest, opts ...grpc.CallOption) (*statistico.TeamsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamsByCompetitionId")
+	}
+
+	var r0 *statistico.TeamsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.CompetitionTeamsRequest, ...grpc.CallOption) (*statistico.TeamsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.CompetitionTeamsRequest, ...grpc.CallOption) *statistico.TeamsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*statistico.TeamsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *statistico.CompetitionTeamsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamServiceClient_GetTeamsByCompetitionId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamsByCompetitionId'
+type TeamServiceClient_GetTeamsByCompetitionId_Call struct {
+	*mock.Call
+}
+
+// GetTeamsByCompetitionId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *statistico.CompetitionTeamsRequest
+//   - opts ...grpc.CallOption
+func (_e *TeamServiceClient_Expecter) GetTeamsByCompetitionId(ctx interface{}, in interface{}, opts ...interface{}) *TeamServiceClient_GetTeamsByCompetitionId_Call {
+	return &TeamServiceClient_GetTeamsByCompetitionId_Call{Call: _e.mock.On("GetTeamsByCompetitionId",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TeamServiceClient_GetTeamsByCompetitionId_Call) Run(run func(ctx context.Context, in *statistico.CompetitionTeamsRequest, opts ...grpc.CallOption)) *TeamServiceClient_GetTeamsByCompetitionId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*statistico.CompetitionTeamsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TeamServiceClient_GetTeamsByCompetitionId_Call) Return(_a0 *statistico.TeamsResponse, _a1 error) *TeamServiceClient_GetTeamsByCompetitionId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamServiceClient_GetTeamsByCompetitionId_Call) RunAndReturn(run func(context.Context, *statistico.CompetitionTeamsRequest, ...grpc.CallOption) (*statistico.TeamsResponse, error)) *TeamServiceClient_GetTeamsByCompetitionId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamsBySeasonId provides a mock function with given fields: ctx, in, opts
+func (_m *TeamServiceClient) GetTeamsBySeasonId(ctx context.Context, in *statistico.SeasonTeamsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Team], error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamsBySeasonId")
+	}
+
+	var r0 grpc.ServerStreamingClient[statistico.Team]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.SeasonTeamsRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Team], error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.SeasonTeamsRequest, ...grpc.CallOption) grpc.ServerStreamingClient[statistico.Team]); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(grpc.ServerStreamingClient[statistico.Team])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *statistico.SeasonTeamsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamServiceClient_GetTeamsBySeasonId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamsBySeasonId'
+type TeamServiceClient_GetTeamsBySeasonId_Call struct {
+	*mock.Call
+}
+
+// GetTeamsBySeasonId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *statistico.SeasonTeamsRequest
+//   - opts ...grpc.CallOption
+func (_e *TeamServiceClient_Expecter) GetTeamsBySeasonId(ctx interface{}, in interface{}, opts ...interface{}) *TeamServiceClient_GetTeamsBySeasonId_Call {
+	return &TeamServiceClient_GetTeamsBySeasonId_Call{Call: _e.mock.On("GetTeamsBySeasonId",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TeamServiceClient_GetTeamsBySeasonId_Call) Run(run func(ctx context.Context, in *statistico.SeasonTeamsRequest, opts ...grpc.CallOption)) *TeamServiceClient_GetTeamsBySeasonId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*statistico.SeasonTeamsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TeamServiceClient_GetTeamsBySeasonId_Call) Return(_a0 grpc.ServerStreamingClient[statistico.Team], _a1 error) *TeamServiceClient_GetTeamsBySeasonId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamServiceClient_GetTeamsBySeasonId_Call) RunAndReturn(run func(context.Context, *statistico.SeasonTeamsRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Team], error)) *TeamServiceClient_GetTeamsBySeasonId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTeamServiceClient creates a new instance of TeamServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTeamServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TeamServiceClient {
+	mock := &TeamServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,114 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	statistico "github.com/statistico/statistico-proto/go"
+)
+
+// TeamStatsServiceClient is an autogenerated mock type for the TeamStatsServiceClient type
+type TeamStatsServiceClient struct {
+	mock.Mock
+}
+
+type TeamStatsServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TeamStatsServiceClient) EXPECT() *TeamStatsServiceClient_Expecter {
+	return &TeamStatsServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// GetTeamStatsForFixture provides a mock function with given fields: ctx, in, opts
+func (_m *TeamStatsServiceClient) GetTeamStatsForFixture(ctx context.Context, in *statistico.FixtureRequest, opts ...grpc.CallOption) (*statistico.TeamStatsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamStatsForFixture")
+	}
+
+	var r0 *statistico.TeamStatsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.FixtureRequest, ...grpc.CallOption) (*statistico.TeamStatsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *statistico.FixtureRequest, ...grpc.CallOption) *statistico.TeamStatsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*statistico.TeamStatsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *statistico.FixtureRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamStatsServiceClient_GetTeamStatsForFixture_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamStatsForFixture'
+type TeamStatsServiceClient_GetTeamStatsForFixture_Call struct {
+	*mock.Call
+}
+
+// GetTeamStatsForFixture is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *statistico.FixtureRequest
+//   - opts ...grpc.CallOption
+func (_e *TeamStatsServiceClient_Expecter) GetTeamStatsForFixture(ctx interface{}, in interface{}, opts ...interface{}) *TeamStatsServiceClient_GetTeamStatsForFixture_Call {
+	return &TeamStatsServiceClient_GetTeamStatsForFixture_Call{Call: _e.mock.On("GetTeamStatsForFixture",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *TeamStatsServiceClient_GetTeamStatsForFixture_Call) Run(run func(ctx context.Context, in *statistico.FixtureRequest, opts ...grpc.CallOption)) *TeamStatsServiceClient_GetTeamStatsForFixture_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*statistico.FixtureRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TeamStatsServiceClient_GetTeamStatsForFixture_Call) Return(_a0 *statistico.TeamStatsResponse, _a1 error) *TeamStatsServiceClient_GetTeamStatsForFixture_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamStatsServiceClient_GetTeamStatsForFixture_Call) RunAndReturn(run func(context.Context, *statistico.FixtureRequest, ...grpc.CallOption) (*statistico.TeamStatsResponse, error)) *TeamStatsServiceClient_GetTeamStatsForFixture_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTeamStatsServiceClient creates a new instance of TeamStatsServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTeamStatsServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TeamStatsServiceClient {
+	mock := &TeamStatsServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
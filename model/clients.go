@@ -0,0 +1,238 @@
+package model
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+)
+
+// CompetitionClient is a variant of statisticofootballdata.CompetitionClient returning model types.
+type CompetitionClient interface {
+	ByCountryID(ctx context.Context, countryID uint64) ([]Competition, error)
+}
+
+// EventClient is a variant of statisticofootballdata.EventClient returning model types.
+type EventClient interface {
+	FixtureEvents(ctx context.Context, fixtureID uint64) (FixtureEvents, error)
+}
+
+// FixtureClient is a variant of statisticofootballdata.FixtureClient returning model types.
+type FixtureClient interface {
+	Search(ctx context.Context, search FixtureSearch) ([]Fixture, error)
+	ByID(ctx context.Context, fixtureID uint64) (Fixture, error)
+}
+
+// PlayerClient is a variant of statisticofootballdata.PlayerClient returning model types.
+type PlayerClient interface {
+	ByID(ctx context.Context, playerID uint64) (Player, error)
+}
+
+// PlayerStatsClient is a variant of statisticofootballdata.PlayerStatsClient returning model types.
+type PlayerStatsClient interface {
+	FixtureStats(ctx context.Context, fixtureID uint64) (FixturePlayerStats, error)
+}
+
+// SeasonClient is a variant of statisticofootballdata.SeasonClient returning model types.
+type SeasonClient interface {
+	ByTeamID(ctx context.Context, teamID uint64, sort SeasonSort) ([]Season, error)
+	ByCompetitionID(ctx context.Context, competitionID uint64, sort SeasonSort) ([]Season, error)
+}
+
+// TeamClient is a variant of statisticofootballdata.TeamClient returning model types.
+type TeamClient interface {
+	ByID(ctx context.Context, teamID uint64) (Team, error)
+	BySeasonID(ctx context.Context, seasonID uint64) ([]Team, error)
+}
+
+// TeamStatClient is a variant of statisticofootballdata.TeamStatClient returning model types.
+type TeamStatClient interface {
+	Stats(ctx context.Context, fixtureID uint64) (FixtureTeamStats, error)
+}
+
+// Client provides the model variant of each client.
+type Client struct {
+	Competitions CompetitionClient
+	Events       EventClient
+	Fixtures     FixtureClient
+	Players      PlayerClient
+	PlayerStats  PlayerStatsClient
+	Seasons      SeasonClient
+	Teams        TeamClient
+	TeamStats    TeamStatClient
+}
+
+// NewClient returns the model variants of each of the clients of c.
+func NewClient(c *statisticofootballdata.Client) *Client {
+	return &Client{
+		Competitions: NewCompetitionClient(c.Competitions),
+		Events:       NewEventClient(c.Events),
+		Fixtures:     NewFixtureClient(c.Fixtures),
+		Players:      NewPlayerClient(c.Players),
+		PlayerStats:  NewPlayerStatsClient(c.PlayerStats),
+		Seasons:      NewSeasonClient(c.Seasons),
+		Teams:        NewTeamClient(c.Teams),
+		TeamStats:    NewTeamStatClient(c.TeamStats),
+	}
+}
+
+type competitionClient struct {
+	client statisticofootballdata.CompetitionClient
+}
+
+func (c *competitionClient) ByCountryID(ctx context.Context, countryID uint64) ([]Competition, error) {
+	competitions, err := c.client.ByCountryID(ctx, countryID)
+
+	return convert(competitions, CompetitionFromProto), err
+}
+
+// NewCompetitionClient returns a CompetitionClient converting the responses of c to model types.
+func NewCompetitionClient(c statisticofootballdata.CompetitionClient) CompetitionClient {
+	return &competitionClient{client: c}
+}
+
+type eventClient struct {
+	client statisticofootballdata.EventClient
+}
+
+func (e *eventClient) FixtureEvents(ctx context.Context, fixtureID uint64) (FixtureEvents, error) {
+	events, err := e.client.FixtureEvents(ctx, fixtureID)
+
+	if err != nil {
+		return FixtureEvents{}, err
+	}
+
+	return FixtureEventsFromProto(events), nil
+}
+
+// NewEventClient returns an EventClient converting the responses of c to model types.
+func NewEventClient(c statisticofootballdata.EventClient) EventClient {
+	return &eventClient{client: c}
+}
+
+type fixtureClient struct {
+	client statisticofootballdata.FixtureClient
+}
+
+func (f *fixtureClient) Search(ctx context.Context, search FixtureSearch) ([]Fixture, error) {
+	fixtures, err := f.client.Search(ctx, search.ToProto())
+
+	return convert(fixtures, FixtureFromProto), err
+}
+
+func (f *fixtureClient) ByID(ctx context.Context, fixtureID uint64) (Fixture, error) {
+	fixture, err := f.client.ByID(ctx, fixtureID)
+
+	if err != nil {
+		return Fixture{}, err
+	}
+
+	return FixtureFromProto(fixture), nil
+}
+
+// NewFixtureClient returns a FixtureClient converting the responses of c to model types.
+func NewFixtureClient(c statisticofootballdata.FixtureClient) FixtureClient {
+	return &fixtureClient{client: c}
+}
+
+type playerClient struct {
+	client statisticofootballdata.PlayerClient
+}
+
+func (p *playerClient) ByID(ctx context.Context, playerID uint64) (Player, error) {
+	player, err := p.client.ByID(ctx, playerID)
+
+	if err != nil {
+		return Player{}, err
+	}
+
+	return PlayerFromProto(player), nil
+}
+
+// NewPlayerClient returns a PlayerClient converting the responses of c to model types.
+func NewPlayerClient(c statisticofootballdata.PlayerClient) PlayerClient {
+	return &playerClient{client: c}
+}
+
+type playerStatsClient struct {
+	client statisticofootballdata.PlayerStatsClient
+}
+
+func (p *playerStatsClient) FixtureStats(ctx context.Context, fixtureID uint64) (FixturePlayerStats, error) {
+	stats, err := p.client.FixtureStats(ctx, &statistico.FixtureRequest{FixtureId: fixtureID})
+
+	if err != nil {
+		return FixturePlayerStats{}, err
+	}
+
+	return FixturePlayerStatsFromProto(stats), nil
+}
+
+// NewPlayerStatsClient returns a PlayerStatsClient converting the responses of c to model types.
+func NewPlayerStatsClient(c statisticofootballdata.PlayerStatsClient) PlayerStatsClient {
+	return &playerStatsClient{client: c}
+}
+
+type seasonClient struct {
+	client statisticofootballdata.SeasonClient
+}
+
+func (s *seasonClient) ByTeamID(ctx context.Context, teamID uint64, sort SeasonSort) ([]Season, error) {
+	seasons, err := s.client.ByTeamID(ctx, teamID, string(sort))
+
+	return convert(seasons, SeasonFromProto), err
+}
+
+func (s *seasonClient) ByCompetitionID(ctx context.Context, competitionID uint64, sort SeasonSort) ([]Season, error) {
+	seasons, err := s.client.ByCompetitionID(ctx, competitionID, string(sort))
+
+	return convert(seasons, SeasonFromProto), err
+}
+
+// NewSeasonClient returns a SeasonClient converting the responses of c to model types.
+func NewSeasonClient(c statisticofootballdata.SeasonClient) SeasonClient {
+	return &seasonClient{client: c}
+}
+
+type teamClient struct {
+	client statisticofootballdata.TeamClient
+}
+
+func (t *teamClient) ByID(ctx context.Context, teamID uint64) (Team, error) {
+	team, err := t.client.ByID(ctx, teamID)
+
+	if err != nil {
+		return Team{}, err
+	}
+
+	return TeamFromProto(team), nil
+}
+
+func (t *teamClient) BySeasonID(ctx context.Context, seasonID uint64) ([]Team, error) {
+	teams, err := t.client.BySeasonID(ctx, seasonID)
+
+	return convert(teams, TeamFromProto), err
+}
+
+// NewTeamClient returns a TeamClient converting the responses of c to model types.
+func NewTeamClient(c statisticofootballdata.TeamClient) TeamClient {
+	return &teamClient{client: c}
+}
+
+type teamStatClient struct {
+	client statisticofootballdata.TeamStatClient
+}
+
+func (t *teamStatClient) Stats(ctx context.Context, fixtureID uint64) (FixtureTeamStats, error) {
+	stats, err := t.client.Stats(ctx, &statistico.FixtureRequest{FixtureId: fixtureID})
+
+	if err != nil {
+		return FixtureTeamStats{}, err
+	}
+
+	return FixtureTeamStatsFromProto(stats), nil
+}
+
+// NewTeamStatClient returns a TeamStatClient converting the responses of c to model types.
+func NewTeamStatClient(c statisticofootballdata.TeamStatClient) TeamStatClient {
+	return &teamStatClient{client: c}
+}
@@ -0,0 +1,122 @@
+package model_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-football-data-go-grpc-client/model"
+	"github.com/statistico/statistico-football-data-go-grpc-client/statisticofootballdatatest"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestClient(t *testing.T) {
+	t.Run("returns model types from every client", func(t *testing.T) {
+		t.Helper()
+
+		srv := statisticofootballdatatest.NewServer(dataset(t))
+		t.Cleanup(srv.Close)
+
+		client := model.NewClient(srv.Client())
+		ctx := context.Background()
+
+		competitions, err := client.Competitions.ByCountryID(ctx, 462)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []model.Competition{
+			{ID: 8, Name: "Premier League", CountryID: 462, Type: model.CompetitionTypeDomestic},
+			{ID: 24, Name: "FA Cup", CountryID: 462, Type: model.CompetitionTypeCup},
+		}, competitions)
+
+		seasons, err := client.Seasons.ByTeamID(ctx, 1, model.SeasonSortNameDesc)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []model.Season{{ID: 17420, Name: "2020/2021", IsCurrent: true}, {ID: 16036, Name: "2019/2020"}}, seasons)
+
+		team, err := client.Teams.ByID(ctx, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, model.Team{ID: 1, Name: "West Ham United", ShortCode: "WHU", CountryID: 462, VenueID: 214}, team)
+
+		fixtures, err := client.Fixtures.Search(ctx, model.FixtureSearch{
+			TeamID: 1,
+			After:  time.Date(2019, time.September, 15, 0, 0, 0, 0, time.UTC),
+			Sort:   model.FixtureSortDateAsc,
+		})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(fixtures))
+		assert.Equal(t, uint64(193), fixtures[0].ID)
+		assert.Equal(t, time.Date(2019, time.September, 21, 15, 0, 0, 0, time.UTC), fixtures[0].KickOff)
+
+		events, err := client.Events.FixtureEvents(ctx, 192)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, model.Score{Home: 1}, events.Score())
+
+		stats, err := client.TeamStats.Stats(ctx, 192)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 7, stats.Home.Corners)
+		assert.Equal(t, 3, stats.Away.Corners)
+
+		playerStats, err := client.PlayerStats.FixtureStats(ctx, 192)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, uint64(37), playerStats.Home[0].PlayerID)
+
+		player, err := client.Players.ByID(ctx, 37)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "Mark Noble", player.Name)
+	})
+
+	t.Run("returns errors from the wrapped clients", func(t *testing.T) {
+		t.Helper()
+
+		client := model.NewTeamClient(statisticofootballdatatest.NewTeamClient(dataset(t)))
+
+		team, err := client.ByID(context.Background(), 404)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.IsType(t, statisticofootballdata.ErrorNotFound{}, err)
+		assert.Equal(t, model.Team{}, team)
+	})
+}
+
+func dataset(t *testing.T) *statisticofootballdatatest.Dataset {
+	ds, err := statisticofootballdatatest.LoadDataset("../statisticofootballdatatest/testdata/dataset.json")
+
+	if err != nil {
+		t.Fatalf("Expected nil, got %s", err.Error())
+	}
+
+	return ds
+}
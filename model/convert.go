@@ -0,0 +1,727 @@
+package model
+
+import (
+	"github.com/statistico/statistico-proto/go"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"time"
+)
+
+// dateLayouts are the layouts dates without times, such as dates of birth, are parsed with.
+var dateLayouts = []string{time.DateOnly, "02/01/2006", time.RFC3339}
+
+func CompetitionFromProto(c *statistico.Competition) Competition {
+	return Competition{
+		ID:        c.GetId(),
+		Name:      c.GetName(),
+		CountryID: c.GetCountryId(),
+		ImagePath: c.GetImagePath(),
+		Type:      CompetitionType(c.GetType()),
+	}
+}
+
+func (c Competition) ToProto() *statistico.Competition {
+	return &statistico.Competition{
+		Id:        c.ID,
+		Name:      c.Name,
+		CountryId: c.CountryID,
+		ImagePath: c.ImagePath,
+		Type:      string(c.Type),
+	}
+}
+
+func SeasonFromProto(s *statistico.Season) Season {
+	return Season{ID: s.GetId(), Name: s.GetName(), IsCurrent: s.GetIsCurrent().GetValue()}
+}
+
+func (s Season) ToProto() *statistico.Season {
+	return &statistico.Season{Id: s.ID, Name: s.Name, IsCurrent: wrapperspb.Bool(s.IsCurrent)}
+}
+
+func TeamFromProto(t *statistico.Team) Team {
+	return Team{
+		ID:        t.GetId(),
+		Name:      t.GetName(),
+		ShortCode: t.GetShortCode().GetValue(),
+		CountryID: t.GetCountryId(),
+		VenueID:   t.GetVenueId(),
+		Type:      t.GetType(),
+		Gender:    Gender(t.GetGender()),
+		Founded:   int(t.GetFounded().GetValue()),
+		Logo:      t.GetLogo(),
+	}
+}
+
+// ToProto converts the team to its proto type, leaving the short code and year founded unset if they
+// are unknown.
+func (t Team) ToProto() *statistico.Team {
+	p := &statistico.Team{
+		Id:        t.ID,
+		Name:      t.Name,
+		CountryId: t.CountryID,
+		VenueId:   t.VenueID,
+		Type:      t.Type,
+		Gender:    string(t.Gender),
+		Logo:      t.Logo,
+	}
+
+	if t.ShortCode != "" {
+		p.ShortCode = wrapperspb.String(t.ShortCode)
+	}
+
+	if t.Founded != 0 {
+		p.Founded = wrapperspb.UInt64(uint64(t.Founded))
+	}
+
+	return p
+}
+
+func RoundFromProto(r *statistico.Round) Round {
+	return Round{
+		ID:       r.GetId(),
+		Name:     r.GetName(),
+		SeasonID: r.GetSeasonId(),
+		Start:    parseDate(r.GetStartDate()),
+		End:      parseDate(r.GetEndDate()),
+	}
+}
+
+func (r Round) ToProto() *statistico.Round {
+	return &statistico.Round{
+		Id:        r.ID,
+		Name:      r.Name,
+		SeasonId:  r.SeasonID,
+		StartDate: formatDate(r.Start),
+		EndDate:   formatDate(r.End),
+	}
+}
+
+func VenueFromProto(v *statistico.Venue) Venue {
+	return Venue{ID: v.GetId(), Name: v.GetName()}
+}
+
+func (v Venue) ToProto() *statistico.Venue {
+	return &statistico.Venue{Id: v.ID, Name: v.Name}
+}
+
+func FixtureFromProto(f *statistico.Fixture) Fixture {
+	return Fixture{
+		ID:          uint64(f.GetId()),
+		Competition: CompetitionFromProto(f.GetCompetition()),
+		Season:      SeasonFromProto(f.GetSeason()),
+		HomeTeam:    TeamFromProto(f.GetHomeTeam()),
+		AwayTeam:    TeamFromProto(f.GetAwayTeam()),
+		Round:       RoundFromProto(f.GetRound()),
+		Venue:       VenueFromProto(f.GetVenue()),
+		KickOff:     timeFromProto(f.GetDateTime()),
+	}
+}
+
+// ToProto converts the fixture to its proto type, leaving the competition, season, round and venue unset
+// if they are zero values.
+func (f Fixture) ToProto() *statistico.Fixture {
+	p := &statistico.Fixture{
+		Id:       int64(f.ID),
+		HomeTeam: f.HomeTeam.ToProto(),
+		AwayTeam: f.AwayTeam.ToProto(),
+		DateTime: timeToProto(f.KickOff),
+	}
+
+	if f.Competition != (Competition{}) {
+		p.Competition = f.Competition.ToProto()
+	}
+
+	if f.Season != (Season{}) {
+		p.Season = f.Season.ToProto()
+	}
+
+	if f.Round != (Round{}) {
+		p.Round = f.Round.ToProto()
+	}
+
+	if f.Venue != (Venue{}) {
+		p.Venue = f.Venue.ToProto()
+	}
+
+	return p
+}
+
+// ToProto converts the search to a request, formatting times as RFC3339 dates as the data service
+// expects.
+func (s FixtureSearch) ToProto() *statistico.FixtureSearchRequest {
+	req := &statistico.FixtureSearchRequest{SeasonIds: s.SeasonIDs}
+
+	if s.TeamID != 0 {
+		req.TeamId = wrapperspb.UInt64(s.TeamID)
+	}
+
+	if !s.Before.IsZero() {
+		req.DateBefore = wrapperspb.String(s.Before.UTC().Format(time.RFC3339))
+	}
+
+	if !s.After.IsZero() {
+		req.DateAfter = wrapperspb.String(s.After.UTC().Format(time.RFC3339))
+	}
+
+	if s.Sort != "" {
+		req.Sort = wrapperspb.String(string(s.Sort))
+	}
+
+	if s.Limit != 0 {
+		req.Limit = wrapperspb.UInt64(s.Limit)
+	}
+
+	return req
+}
+
+func PlayerFromProto(p *statistico.Player) Player {
+	return Player{
+		ID:            p.GetId(),
+		CountryID:     p.GetCountryId(),
+		NationalityID: p.GetNationalityId(),
+		CommonName:    p.GetCommonName(),
+		FirstName:     p.GetFirstName(),
+		LastName:      p.GetLastName(),
+		Name:          p.GetName(),
+		DisplayName:   p.GetDisplayName(),
+		ImagePath:     p.GetImagePath(),
+		Height:        int(p.GetHeight()),
+		Weight:        int(p.GetWeight()),
+		DateOfBirth:   parseDate(p.GetDateOfBirth()),
+		Gender:        Gender(p.GetGender()),
+		CreatedAt:     timeFromProto(p.GetCreatedAt()),
+		UpdatedAt:     timeFromProto(p.GetUpdatedAt()),
+	}
+}
+
+func (p Player) ToProto() *statistico.Player {
+	return &statistico.Player{
+		Id:            p.ID,
+		CountryId:     p.CountryID,
+		NationalityId: p.NationalityID,
+		CommonName:    p.CommonName,
+		FirstName:     p.FirstName,
+		LastName:      p.LastName,
+		Name:          p.Name,
+		DisplayName:   p.DisplayName,
+		ImagePath:     p.ImagePath,
+		Height:        int32(p.Height),
+		Weight:        int32(p.Weight),
+		DateOfBirth:   formatDate(p.DateOfBirth),
+		Gender:        string(p.Gender),
+		CreatedAt:     timeToProto(p.CreatedAt),
+		UpdatedAt:     timeToProto(p.UpdatedAt),
+	}
+}
+
+// GoalFromProto converts a goal event, leaving Score as a zero value if the data service's score can't
+// be parsed.
+func GoalFromProto(g *statistico.GoalEvent) Goal {
+	score, _ := ParseScore(g.GetScore())
+
+	return Goal{
+		ID:             g.GetId(),
+		TeamID:         g.GetTeamId(),
+		PlayerID:       g.GetPlayerId(),
+		AssistPlayerID: g.GetPlayerAssistId().GetValue(),
+		Minute:         int(g.GetMinute()),
+		Score:          score,
+	}
+}
+
+func (g Goal) ToProto() *statistico.GoalEvent {
+	p := &statistico.GoalEvent{
+		Id:       g.ID,
+		TeamId:   g.TeamID,
+		PlayerId: g.PlayerID,
+		Minute:   uint32(g.Minute),
+		Score:    g.Score.String(),
+	}
+
+	if g.AssistPlayerID != 0 {
+		p.PlayerAssistId = wrapperspb.UInt64(g.AssistPlayerID)
+	}
+
+	return p
+}
+
+func CardFromProto(c *statistico.CardEvent) Card {
+	return Card{
+		ID:       c.GetId(),
+		TeamID:   c.GetTeamId(),
+		PlayerID: c.GetPlayerId(),
+		Type:     CardType(c.GetType()),
+		Minute:   int(c.GetMinute()),
+	}
+}
+
+func (c Card) ToProto() *statistico.CardEvent {
+	return &statistico.CardEvent{
+		Id:       c.ID,
+		TeamId:   c.TeamID,
+		PlayerId: c.PlayerID,
+		Type:     string(c.Type),
+		Minute:   uint32(c.Minute),
+	}
+}
+
+func FixtureEventsFromProto(e *statistico.FixtureEventsResponse) FixtureEvents {
+	return FixtureEvents{
+		FixtureID: e.GetFixtureId(),
+		Goals:     convert(e.GetGoals(), GoalFromProto),
+		Cards:     convert(e.GetCards(), CardFromProto),
+	}
+}
+
+func (e FixtureEvents) ToProto() *statistico.FixtureEventsResponse {
+	return &statistico.FixtureEventsResponse{
+		FixtureId: e.FixtureID,
+		Goals:     convert(e.Goals, Goal.ToProto),
+		Cards:     convert(e.Cards, Card.ToProto),
+	}
+}
+
+func LineupPlayerFromProto(p *statistico.LineupPlayer) LineupPlayer {
+	return LineupPlayer{
+		PlayerID:          p.GetPlayerId(),
+		Position:          Position(p.GetPosition()),
+		FormationPosition: int(p.GetFormationPosition().GetValue()),
+		IsSubstitute:      p.GetIsSubstitute(),
+	}
+}
+
+func (p LineupPlayer) ToProto() *statistico.LineupPlayer {
+	lp := &statistico.LineupPlayer{
+		PlayerId:     p.PlayerID,
+		Position:     string(p.Position),
+		IsSubstitute: p.IsSubstitute,
+	}
+
+	if p.FormationPosition != 0 {
+		lp.FormationPosition = wrapperspb.UInt32(uint32(p.FormationPosition))
+	}
+
+	return lp
+}
+
+func LineupFromProto(l *statistico.Lineup) Lineup {
+	return Lineup{
+		Start: convert(l.GetStart(), LineupPlayerFromProto),
+		Bench: convert(l.GetBench(), LineupPlayerFromProto),
+	}
+}
+
+func (l Lineup) ToProto() *statistico.Lineup {
+	return &statistico.Lineup{
+		Start: convert(l.Start, LineupPlayer.ToProto),
+		Bench: convert(l.Bench, LineupPlayer.ToProto),
+	}
+}
+
+func FixtureLineupsFromProto(l *statistico.LineupResponse) FixtureLineups {
+	return FixtureLineups{Home: LineupFromProto(l.GetHomeTeam()), Away: LineupFromProto(l.GetAwayTeam())}
+}
+
+func (l FixtureLineups) ToProto() *statistico.LineupResponse {
+	return &statistico.LineupResponse{HomeTeam: l.Home.ToProto(), AwayTeam: l.Away.ToProto()}
+}
+
+func TeamStatsFromProto(s *statistico.TeamStats) TeamStats {
+	return TeamStats{
+		TeamID:         s.GetTeamId(),
+		FixtureID:      s.GetFixtureId(),
+		Assists:        intFromProto(s.GetAssists()),
+		BallPossession: intFromProto(s.GetBallPossession()),
+		BallSafe:       intFromProto(s.GetBallSafe()),
+		Challenges:     intFromProto(s.GetChallenges()),
+		Corners:        intFromProto(s.GetCorners()),
+		DuelsWon:       intFromProto(s.GetDuelsWon()),
+		FreeKicks:      intFromProto(s.GetFreeKicks()),
+		Fouls:          intFromProto(s.GetFouls()),
+		GoalKicks:      intFromProto(s.GetGoalKicks()),
+		HitWoodwork:    intFromProto(s.GetHitWoodwork()),
+		Injuries:       intFromProto(s.GetInjuries()),
+		Interceptions:  intFromProto(s.GetInterceptions()),
+		Offsides:       intFromProto(s.GetOffsides()),
+		Penalties:      intFromProto(s.GetPenalties()),
+		Saves:          intFromProto(s.GetSaves()),
+		Substitutions:  intFromProto(s.GetSubstitutions()),
+		Tackles:        intFromProto(s.GetTackles()),
+		Attacks: TeamAttacks{
+			Counter:   intFromProto(s.GetTeamAttacks().GetCounter()),
+			Total:     intFromProto(s.GetTeamAttacks().GetTotal()),
+			Dangerous: intFromProto(s.GetTeamAttacks().GetDangerous()),
+		},
+		Cards: TeamCards{
+			Red:       intFromProto(s.GetTeamCards().GetRed()),
+			Yellow:    intFromProto(s.GetTeamCards().GetYellow()),
+			YellowRed: intFromProto(s.GetTeamCards().GetYellowRed()),
+		},
+		Crosses: TeamCrosses{
+			Total:    intFromProto(s.GetTeamCrosses().GetTotal()),
+			Accurate: intFromProto(s.GetTeamCrosses().GetAccurate()),
+		},
+		Dribbles: TeamDribbles{
+			Total:   intFromProto(s.GetTeamDribbles().GetTotal()),
+			Success: intFromProto(s.GetTeamDribbles().GetSuccess()),
+		},
+		Goals: TeamGoals{
+			Scored:           intFromProto(s.GetTeamGoals().GetScored()),
+			ScoredHalfTime:   intFromProto(s.GetTeamGoals().GetScoredHalfTime()),
+			Conceded:         intFromProto(s.GetTeamGoals().GetConceded()),
+			ConcededHalfTime: intFromProto(s.GetTeamGoals().GetConcededHalfTime()),
+			Attempts:         intFromProto(s.GetTeamGoals().GetAttempts()),
+		},
+		Headers: TeamHeaders{
+			Total:   intFromProto(s.GetTeamHeaders().GetTotal()),
+			Success: intFromProto(s.GetTeamHeaders().GetSuccess()),
+		},
+		Passes: TeamPasses{
+			Total:      intFromProto(s.GetTeamPasses().GetTotal()),
+			Success:    intFromProto(s.GetTeamPasses().GetSuccess()),
+			Percentage: intFromProto(s.GetTeamPasses().GetPercentage()),
+			Key:        intFromProto(s.GetTeamPasses().GetKey()),
+			Long:       intFromProto(s.GetTeamPasses().GetLong()),
+		},
+		Shots: TeamShots{
+			Total:      intFromProto(s.GetTeamShots().GetTotal()),
+			OnGoal:     intFromProto(s.GetTeamShots().GetOnGoal()),
+			OffGoal:    intFromProto(s.GetTeamShots().GetOffGoal()),
+			Blocked:    intFromProto(s.GetTeamShots().GetBlocked()),
+			InsideBox:  intFromProto(s.GetTeamShots().GetInsideBox()),
+			OutsideBox: intFromProto(s.GetTeamShots().GetOutsideBox()),
+		},
+		ThrowIns:   intFromProto(s.GetThrowIns()),
+		Treatments: intFromProto(s.GetTreatments()),
+		CreatedAt:  timeFromProto(s.GetCreatedAt()),
+		UpdatedAt:  timeFromProto(s.GetUpdatedAt()),
+	}
+}
+
+// ToProto converts the stats to their proto type, setting every stat including those that are zero.
+func (s TeamStats) ToProto() *statistico.TeamStats {
+	return &statistico.TeamStats{
+		TeamId:         s.TeamID,
+		FixtureId:      s.FixtureID,
+		Assists:        intToProto(s.Assists),
+		BallPossession: intToProto(s.BallPossession),
+		BallSafe:       intToProto(s.BallSafe),
+		Challenges:     intToProto(s.Challenges),
+		Corners:        intToProto(s.Corners),
+		DuelsWon:       intToProto(s.DuelsWon),
+		FreeKicks:      intToProto(s.FreeKicks),
+		Fouls:          intToProto(s.Fouls),
+		GoalKicks:      intToProto(s.GoalKicks),
+		HitWoodwork:    intToProto(s.HitWoodwork),
+		Injuries:       intToProto(s.Injuries),
+		Interceptions:  intToProto(s.Interceptions),
+		Offsides:       intToProto(s.Offsides),
+		Penalties:      intToProto(s.Penalties),
+		Saves:          intToProto(s.Saves),
+		Substitutions:  intToProto(s.Substitutions),
+		Tackles:        intToProto(s.Tackles),
+		TeamAttacks: &statistico.TeamAttacks{
+			Counter:   intToProto(s.Attacks.Counter),
+			Total:     intToProto(s.Attacks.Total),
+			Dangerous: intToProto(s.Attacks.Dangerous),
+		},
+		TeamCards: &statistico.TeamCards{
+			Red:       intToProto(s.Cards.Red),
+			Yellow:    intToProto(s.Cards.Yellow),
+			YellowRed: intToProto(s.Cards.YellowRed),
+		},
+		TeamCrosses: &statistico.TeamCrosses{
+			Total:    intToProto(s.Crosses.Total),
+			Accurate: intToProto(s.Crosses.Accurate),
+		},
+		TeamDribbles: &statistico.TeamDribbles{
+			Total:   intToProto(s.Dribbles.Total),
+			Success: intToProto(s.Dribbles.Success),
+		},
+		TeamGoals: &statistico.TeamGoals{
+			Scored:           intToProto(s.Goals.Scored),
+			ScoredHalfTime:   intToProto(s.Goals.ScoredHalfTime),
+			Conceded:         intToProto(s.Goals.Conceded),
+			ConcededHalfTime: intToProto(s.Goals.ConcededHalfTime),
+			Attempts:         intToProto(s.Goals.Attempts),
+		},
+		TeamHeaders: &statistico.TeamHeaders{
+			Total:   intToProto(s.Headers.Total),
+			Success: intToProto(s.Headers.Success),
+		},
+		TeamPasses: &statistico.TeamPasses{
+			Total:      intToProto(s.Passes.Total),
+			Success:    intToProto(s.Passes.Success),
+			Percentage: intToProto(s.Passes.Percentage),
+			Key:        intToProto(s.Passes.Key),
+			Long:       intToProto(s.Passes.Long),
+		},
+		TeamShots: &statistico.TeamShots{
+			Total:      intToProto(s.Shots.Total),
+			OnGoal:     intToProto(s.Shots.OnGoal),
+			OffGoal:    intToProto(s.Shots.OffGoal),
+			Blocked:    intToProto(s.Shots.Blocked),
+			InsideBox:  intToProto(s.Shots.InsideBox),
+			OutsideBox: intToProto(s.Shots.OutsideBox),
+		},
+		ThrowIns:   intToProto(s.ThrowIns),
+		Treatments: intToProto(s.Treatments),
+		CreatedAt:  timeToProto(s.CreatedAt),
+		UpdatedAt:  timeToProto(s.UpdatedAt),
+	}
+}
+
+func FixtureTeamStatsFromProto(s *statistico.TeamStatsResponse) FixtureTeamStats {
+	return FixtureTeamStats{Home: TeamStatsFromProto(s.GetHomeTeam()), Away: TeamStatsFromProto(s.GetAwayTeam())}
+}
+
+func (s FixtureTeamStats) ToProto() *statistico.TeamStatsResponse {
+	return &statistico.TeamStatsResponse{HomeTeam: s.Home.ToProto(), AwayTeam: s.Away.ToProto()}
+}
+
+func PlayerStatsFromProto(s *statistico.PlayerStats) PlayerStats {
+	return PlayerStats{
+		PlayerID:   s.GetPlayerId(),
+		SeasonID:   s.GetSeasonId(),
+		TeamID:     s.GetTeamId(),
+		FixtureID:  s.GetFixtureId(),
+		PlayerName: s.GetPlayerName(),
+		AerialsWon: intFromProto(s.GetAerialsWon()),
+		Shots: Shots{
+			Total:     intFromProto(s.GetShots().GetTotal()),
+			OnTarget:  intFromProto(s.GetShots().GetOnTarget()),
+			OffTarget: intFromProto(s.GetShots().GetOffTarget()),
+			Blocked:   intFromProto(s.GetShots().GetBlocked()),
+			Stopped:   intFromProto(s.GetShots().GetStopped()),
+		},
+		Goals: Goals{
+			Scored:   intFromProto(s.GetGoals().GetScored()),
+			Conceded: intFromProto(s.GetGoals().GetConceded()),
+		},
+		Fouls: Fouls{
+			Drawn:     intFromProto(s.GetFouls().GetDrawn()),
+			Committed: intFromProto(s.GetFouls().GetCommitted()),
+		},
+		GoalKeeper: GoalKeeper{
+			SavesTotal:     intFromProto(s.GetGoalKeeper().GetSavesTotal()),
+			SavesInsideBox: intFromProto(s.GetGoalKeeper().GetSavesInsideBox()),
+			GoalsConceded:  intFromProto(s.GetGoalKeeper().GetGoalsConceded()),
+			Punches:        intFromProto(s.GetGoalKeeper().GetPunches()),
+		},
+		BigChancesCreated: intFromProto(s.GetBigChancesCreated()),
+		BigChancesMissed:  intFromProto(s.GetBigChancesMissed()),
+		Assists:           intFromProto(s.GetAssists()),
+		Dispossessed:      intFromProto(s.GetDispossessed()),
+		YellowCards:       intFromProto(s.GetYellowCards()),
+		RedCards:          intFromProto(s.GetRedCard()),
+		YellowRedCards:    intFromProto(s.GetYellowRedCard()),
+		Penalties: Penalties{
+			Scored:    intFromProto(s.GetPenalties().GetScored()),
+			Missed:    intFromProto(s.GetPenalties().GetMissed()),
+			Saved:     intFromProto(s.GetPenalties().GetSaved()),
+			Committed: intFromProto(s.GetPenalties().GetCommitted()),
+			Won:       intFromProto(s.GetPenalties().GetWon()),
+		},
+		Crosses: Crosses{
+			Total:    intFromProto(s.GetCrosses().GetTotal()),
+			Accurate: intFromProto(s.GetCrosses().GetAccurate()),
+		},
+		Passes: Passes{
+			Total:    intFromProto(s.GetPasses().GetTotal()),
+			Accurate: intFromProto(s.GetPasses().GetAccurate()),
+			Accuracy: intFromProto(s.GetPasses().GetAccuracy()),
+			Key:      intFromProto(s.GetPasses().GetKey()),
+			Long:     intFromProto(s.GetPasses().GetLong()),
+		},
+		ThroughBalls: ThroughBalls{
+			Total: intFromProto(s.GetThroughBalls().GetTotal()),
+			Won:   intFromProto(s.GetThroughBalls().GetWon()),
+		},
+		Duels: Duels{
+			Total: intFromProto(s.GetDuels().GetTotal()),
+			Won:   intFromProto(s.GetDuels().GetWon()),
+			Lost:  intFromProto(s.GetDuels().GetLost()),
+		},
+		Dribbles: Dribbles{
+			Attempts: intFromProto(s.GetDribbles().GetAttempts()),
+			Success:  intFromProto(s.GetDribbles().GetSuccess()),
+			Past:     intFromProto(s.GetDribbles().GetPast()),
+		},
+		LongBallsWon:      intFromProto(s.GetLongBallsWon()),
+		Offsides:          intFromProto(s.GetOffsides()),
+		HitWoodwork:       intFromProto(s.GetHitWoodwork()),
+		Tackles:           intFromProto(s.GetTackles()),
+		Interceptions:     intFromProto(s.GetInterceptions()),
+		Clearances:        intFromProto(s.GetClearances()),
+		ClearancesOffLine: intFromProto(s.GetClearancesOffLine()),
+		Touches:           intFromProto(s.GetTouches()),
+		MinutesPlayed:     intFromProto(s.GetMinutesPlayed()),
+		Rating:            float64(s.GetRating().GetValue()),
+		OwnGoals:          intFromProto(s.GetOwnGoals()),
+		ErrorLedToGoal:    intFromProto(s.GetErrorLedToGoal()),
+		CreatedAt:         timeFromProto(s.GetCreatedAt()),
+		UpdatedAt:         timeFromProto(s.GetUpdatedAt()),
+	}
+}
+
+// ToProto converts the stats to their proto type, setting every stat including those that are zero.
+func (s PlayerStats) ToProto() *statistico.PlayerStats {
+	return &statistico.PlayerStats{
+		PlayerId:   s.PlayerID,
+		SeasonId:   s.SeasonID,
+		TeamId:     s.TeamID,
+		FixtureId:  s.FixtureID,
+		PlayerName: s.PlayerName,
+		AerialsWon: intToProto(s.AerialsWon),
+		Shots: &statistico.Shots{
+			Total:     intToProto(s.Shots.Total),
+			OnTarget:  intToProto(s.Shots.OnTarget),
+			OffTarget: intToProto(s.Shots.OffTarget),
+			Blocked:   intToProto(s.Shots.Blocked),
+			Stopped:   intToProto(s.Shots.Stopped),
+		},
+		Goals: &statistico.Goals{
+			Scored:   intToProto(s.Goals.Scored),
+			Conceded: intToProto(s.Goals.Conceded),
+		},
+		Fouls: &statistico.Fouls{
+			Drawn:     intToProto(s.Fouls.Drawn),
+			Committed: intToProto(s.Fouls.Committed),
+		},
+		GoalKeeper: &statistico.GoalKeeper{
+			SavesTotal:     intToProto(s.GoalKeeper.SavesTotal),
+			SavesInsideBox: intToProto(s.GoalKeeper.SavesInsideBox),
+			GoalsConceded:  intToProto(s.GoalKeeper.GoalsConceded),
+			Punches:        intToProto(s.GoalKeeper.Punches),
+		},
+		BigChancesCreated: intToProto(s.BigChancesCreated),
+		BigChancesMissed:  intToProto(s.BigChancesMissed),
+		Assists:           intToProto(s.Assists),
+		Dispossessed:      intToProto(s.Dispossessed),
+		YellowCards:       intToProto(s.YellowCards),
+		RedCard:           intToProto(s.RedCards),
+		YellowRedCard:     intToProto(s.YellowRedCards),
+		Penalties: &statistico.Penalties{
+			Scored:    intToProto(s.Penalties.Scored),
+			Missed:    intToProto(s.Penalties.Missed),
+			Saved:     intToProto(s.Penalties.Saved),
+			Committed: intToProto(s.Penalties.Committed),
+			Won:       intToProto(s.Penalties.Won),
+		},
+		Crosses: &statistico.Crosses{
+			Total:    intToProto(s.Crosses.Total),
+			Accurate: intToProto(s.Crosses.Accurate),
+		},
+		Passes: &statistico.Passes{
+			Total:    intToProto(s.Passes.Total),
+			Accurate: intToProto(s.Passes.Accurate),
+			Accuracy: intToProto(s.Passes.Accuracy),
+			Key:      intToProto(s.Passes.Key),
+			Long:     intToProto(s.Passes.Long),
+		},
+		ThroughBalls: &statistico.ThroughBalls{
+			Total: intToProto(s.ThroughBalls.Total),
+			Won:   intToProto(s.ThroughBalls.Won),
+		},
+		Duels: &statistico.Duels{
+			Total: intToProto(s.Duels.Total),
+			Won:   intToProto(s.Duels.Won),
+			Lost:  intToProto(s.Duels.Lost),
+		},
+		Dribbles: &statistico.Dribbles{
+			Attempts: intToProto(s.Dribbles.Attempts),
+			Success:  intToProto(s.Dribbles.Success),
+			Past:     intToProto(s.Dribbles.Past),
+		},
+		LongBallsWon:      intToProto(s.LongBallsWon),
+		Offsides:          intToProto(s.Offsides),
+		HitWoodwork:       intToProto(s.HitWoodwork),
+		Tackles:           intToProto(s.Tackles),
+		Interceptions:     intToProto(s.Interceptions),
+		Clearances:        intToProto(s.Clearances),
+		ClearancesOffLine: intToProto(s.ClearancesOffLine),
+		Touches:           intToProto(s.Touches),
+		MinutesPlayed:     intToProto(s.MinutesPlayed),
+		Rating:            wrapperspb.Float(float32(s.Rating)),
+		OwnGoals:          intToProto(s.OwnGoals),
+		ErrorLedToGoal:    intToProto(s.ErrorLedToGoal),
+		CreatedAt:         timeToProto(s.CreatedAt),
+		UpdatedAt:         timeToProto(s.UpdatedAt),
+	}
+}
+
+func FixturePlayerStatsFromProto(s *statistico.PlayerStatsResponse) FixturePlayerStats {
+	return FixturePlayerStats{
+		Home: convert(s.GetHomeTeam(), PlayerStatsFromProto),
+		Away: convert(s.GetAwayTeam(), PlayerStatsFromProto),
+	}
+}
+
+func (s FixturePlayerStats) ToProto() *statistico.PlayerStatsResponse {
+	return &statistico.PlayerStatsResponse{
+		HomeTeam: convert(s.Home, PlayerStats.ToProto),
+		AwayTeam: convert(s.Away, PlayerStats.ToProto),
+	}
+}
+
+// convert applies fn to each item.
+func convert[T, R any](items []T, fn func(T) R) []R {
+	out := make([]R, len(items))
+
+	for i, item := range items {
+		out[i] = fn(item)
+	}
+
+	return out
+}
+
+func intFromProto(v *wrapperspb.Int32Value) int {
+	return int(v.GetValue())
+}
+
+func intToProto(n int) *wrapperspb.Int32Value {
+	return wrapperspb.Int32(int32(n))
+}
+
+// timeFromProto converts a date to a time in UTC, or the zero time if the date is unset.
+func timeFromProto(d *statistico.Date) time.Time {
+	if d.GetUtc() == 0 && d.GetRfc() == "" {
+		return time.Time{}
+	}
+
+	if d.GetUtc() == 0 {
+		t, err := time.Parse(time.RFC3339, d.GetRfc())
+
+		if err == nil {
+			return t.UTC()
+		}
+	}
+
+	return time.Unix(d.GetUtc(), 0).UTC()
+}
+
+func timeToProto(t time.Time) *statistico.Date {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &statistico.Date{Utc: t.Unix(), Rfc: t.UTC().Format(time.RFC3339)}
+}
+
+// parseDate parses a date in any of the dateLayouts, returning the zero time if it can't be parsed.
+func parseDate(s string) time.Time {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.DateOnly)
+}
@@ -0,0 +1,192 @@
+package model_test
+
+import (
+	"github.com/statistico/statistico-football-data-go-grpc-client/model"
+	"github.com/statistico/statistico-football-data-go-grpc-client/statisticofootballdatatest"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"testing"
+	"time"
+)
+
+func TestFixtureFromProto(t *testing.T) {
+	t.Run("converts kick-offs to UTC times and nested messages to values", func(t *testing.T) {
+		t.Helper()
+
+		f := model.FixtureFromProto(&statistico.Fixture{
+			Id:          192,
+			Competition: &statistico.Competition{Id: 8, Name: "Premier League", Type: "domestic"},
+			Season:      &statistico.Season{Id: 16036, Name: "2019/2020", IsCurrent: wrapperspb.Bool(true)},
+			HomeTeam:    &statistico.Team{Id: 1, Name: "West Ham United", ShortCode: wrapperspb.String("WHU")},
+			AwayTeam:    &statistico.Team{Id: 18, Name: "Chelsea"},
+			Round:       &statistico.Round{Id: 3, Name: "5", SeasonId: 16036, StartDate: "2019-09-14", EndDate: "2019-09-16"},
+			DateTime:    &statistico.Date{Utc: 1568473200, Rfc: "2019-09-14T15:00:00+00:00"},
+		})
+
+		assert.Equal(t, uint64(192), f.ID)
+		assert.Equal(t, model.CompetitionTypeDomestic, f.Competition.Type)
+		assert.True(t, f.Season.IsCurrent)
+		assert.Equal(t, "WHU", f.HomeTeam.ShortCode)
+		assert.Equal(t, "", f.AwayTeam.ShortCode)
+		assert.Equal(t, time.Date(2019, time.September, 14, 15, 0, 0, 0, time.UTC), f.KickOff)
+		assert.Equal(t, time.Date(2019, time.September, 16, 0, 0, 0, 0, time.UTC), f.Round.End)
+		assert.Equal(t, model.Venue{}, f.Venue)
+	})
+
+	t.Run("converts missing dates to zero times", func(t *testing.T) {
+		t.Helper()
+
+		f := model.FixtureFromProto(&statistico.Fixture{Id: 1})
+
+		assert.True(t, f.KickOff.IsZero())
+		assert.Nil(t, f.ToProto().GetDateTime())
+		assert.Nil(t, f.ToProto().GetVenue())
+	})
+}
+
+func TestPlayerFromProto(t *testing.T) {
+	t.Run("parses dates of birth in either of the data service's formats", func(t *testing.T) {
+		t.Helper()
+
+		born := time.Date(1987, time.May, 8, 0, 0, 0, 0, time.UTC)
+
+		assert.Equal(t, born, model.PlayerFromProto(&statistico.Player{DateOfBirth: "1987-05-08"}).DateOfBirth)
+		assert.Equal(t, born, model.PlayerFromProto(&statistico.Player{DateOfBirth: "08/05/1987"}).DateOfBirth)
+		assert.True(t, model.PlayerFromProto(&statistico.Player{DateOfBirth: "unknown"}).DateOfBirth.IsZero())
+		assert.Equal(t, "1987-05-08", model.Player{DateOfBirth: born}.ToProto().GetDateOfBirth())
+	})
+}
+
+func TestFixtureEventsFromProto(t *testing.T) {
+	t.Run("parses scores and assists", func(t *testing.T) {
+		t.Helper()
+
+		events := model.FixtureEventsFromProto(&statistico.FixtureEventsResponse{
+			FixtureId: 192,
+			Goals: []*statistico.GoalEvent{
+				{Id: 1, TeamId: 1, PlayerId: 37, Minute: 55, Score: "1-0", PlayerAssistId: wrapperspb.UInt64(38)},
+				{Id: 2, TeamId: 18, PlayerId: 40, Minute: 80, Score: "1-1"},
+			},
+			Cards: []*statistico.CardEvent{{Id: 3, TeamId: 18, PlayerId: 41, Type: "yellowcard", Minute: 12}},
+		})
+
+		assert.Equal(t, model.Score{Home: 1, Away: 0}, events.Goals[0].Score)
+		assert.Equal(t, uint64(38), events.Goals[0].AssistPlayerID)
+		assert.Equal(t, uint64(0), events.Goals[1].AssistPlayerID)
+		assert.Equal(t, model.CardTypeYellow, events.Cards[0].Type)
+		assert.Equal(t, model.Score{Home: 1, Away: 1}, events.Score())
+		assert.Equal(t, "1-1", events.Score().String())
+		assert.Nil(t, events.ToProto().GetGoals()[1].GetPlayerAssistId())
+	})
+
+	t.Run("returns a zero score for fixtures without goals", func(t *testing.T) {
+		t.Helper()
+
+		assert.Equal(t, model.Score{}, model.FixtureEventsFromProto(&statistico.FixtureEventsResponse{}).Score())
+	})
+}
+
+func TestParseScore(t *testing.T) {
+	t.Run("parses scores and rejects invalid ones", func(t *testing.T) {
+		t.Helper()
+
+		score, err := model.ParseScore("3-2")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, model.Score{Home: 3, Away: 2}, score)
+
+		_, err = model.ParseScore("three")
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, `invalid score "three"`, err.Error())
+	})
+}
+
+func TestFixtureSearch_ToProto(t *testing.T) {
+	t.Run("sets only the filters given", func(t *testing.T) {
+		t.Helper()
+
+		req := model.FixtureSearch{
+			TeamID: 1,
+			Before: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.FixedZone("BST", 3600)),
+			Sort:   model.FixtureSortDateDesc,
+		}.ToProto()
+
+		assert.Equal(t, uint64(1), req.GetTeamId().GetValue())
+		assert.Equal(t, "2019-12-31T23:00:00Z", req.GetDateBefore().GetValue())
+		assert.Nil(t, req.GetDateAfter())
+		assert.Equal(t, "date_desc", req.GetSort().GetValue())
+		assert.Nil(t, req.GetLimit())
+	})
+}
+
+func TestStatsFromProto(t *testing.T) {
+	t.Run("converts missing stats to zero", func(t *testing.T) {
+		t.Helper()
+
+		stats := model.TeamStatsFromProto(&statistico.TeamStats{TeamId: 1, Corners: wrapperspb.Int32(7)})
+
+		assert.Equal(t, 7, stats.Corners)
+		assert.Equal(t, 0, stats.Shots.Total)
+		assert.Equal(t, int32(0), stats.ToProto().GetTeamShots().GetTotal().GetValue())
+
+		ps := model.PlayerStatsFromProto(&statistico.PlayerStats{PlayerId: 37, Rating: wrapperspb.Float(7.5)})
+
+		assert.Equal(t, 7.5, ps.Rating)
+		assert.Equal(t, 0, ps.Goals.Scored)
+	})
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Run("converts generated data to model types and back", func(t *testing.T) {
+		t.Helper()
+
+		ds := statisticofootballdatatest.Generate(statisticofootballdatatest.GenerateConfig{Seed: 5, Teams: 4})
+
+		for _, c := range ds.Competitions {
+			m := model.CompetitionFromProto(c)
+			assert.Equal(t, m, model.CompetitionFromProto(m.ToProto()))
+		}
+
+		for _, s := range ds.Seasons {
+			m := model.SeasonFromProto(s)
+			assert.Equal(t, m, model.SeasonFromProto(m.ToProto()))
+		}
+
+		for _, team := range ds.Teams {
+			m := model.TeamFromProto(team)
+			assert.Equal(t, m, model.TeamFromProto(m.ToProto()))
+		}
+
+		for _, p := range ds.Players {
+			m := model.PlayerFromProto(p)
+			assert.Equal(t, m, model.PlayerFromProto(m.ToProto()))
+		}
+
+		for _, f := range ds.Fixtures {
+			id := uint64(f.GetId())
+
+			fixture := model.FixtureFromProto(f)
+			events := model.FixtureEventsFromProto(ds.Events[id])
+			teamStats := model.FixtureTeamStatsFromProto(ds.TeamStats[id])
+			playerStats := model.FixturePlayerStatsFromProto(ds.PlayerStats[id])
+			lineups := model.FixtureLineupsFromProto(ds.Lineups[id])
+
+			assert.Equal(t, fixture, model.FixtureFromProto(fixture.ToProto()))
+			assert.Equal(t, events, model.FixtureEventsFromProto(events.ToProto()))
+			assert.Equal(t, teamStats, model.FixtureTeamStatsFromProto(teamStats.ToProto()))
+			assert.Equal(t, playerStats, model.FixturePlayerStatsFromProto(playerStats.ToProto()))
+			assert.Equal(t, lineups, model.FixtureLineupsFromProto(lineups.ToProto()))
+
+			assert.Equal(t, events.Score().Home, teamStats.Home.Goals.Scored)
+			assert.Equal(t, 11, len(lineups.Home.Start))
+		}
+	})
+}
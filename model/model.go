@@ -0,0 +1,422 @@
+// Package model provides plain Go types for the data returned by the data service, decoupled from the
+// generated proto types. Kick-offs and other timestamps are time.Time values, scores and stats are ints
+// rather than wrapper messages, and string fields with a known set of values have typed constants.
+//
+// Values missing from the data service's responses, such as stats it has not collected, are converted to
+// zero values. Converters in both directions are provided for each type, alongside client variants
+// returning model types.
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// CompetitionType describes the format of a competition.
+type CompetitionType string
+
+const (
+	CompetitionTypeDomestic CompetitionType = "domestic"
+	CompetitionTypeCup      CompetitionType = "cup"
+)
+
+// Gender is the gender of a team or player.
+type Gender string
+
+const (
+	GenderMale   Gender = "male"
+	GenderFemale Gender = "female"
+)
+
+// CardType is the type of card shown to a player.
+type CardType string
+
+const (
+	CardTypeYellow    CardType = "yellowcard"
+	CardTypeRed       CardType = "redcard"
+	CardTypeYellowRed CardType = "yellowred"
+)
+
+// Position is the position a player plays in a line up.
+type Position string
+
+const (
+	PositionGoalkeeper Position = "G"
+	PositionDefender   Position = "D"
+	PositionMidfielder Position = "M"
+	PositionAttacker   Position = "A"
+)
+
+// SeasonSort is the order seasons are returned in.
+type SeasonSort string
+
+const (
+	SeasonSortNameAsc  SeasonSort = "name_asc"
+	SeasonSortNameDesc SeasonSort = "name_desc"
+)
+
+// FixtureSort is the order fixtures are returned in by a search.
+type FixtureSort string
+
+const (
+	FixtureSortDateAsc  FixtureSort = "date_asc"
+	FixtureSortDateDesc FixtureSort = "date_desc"
+)
+
+type Competition struct {
+	ID        uint64
+	Name      string
+	CountryID uint64
+	ImagePath string
+	Type      CompetitionType
+}
+
+type Season struct {
+	ID        uint64
+	Name      string
+	IsCurrent bool
+}
+
+type Team struct {
+	ID        uint64
+	Name      string
+	ShortCode string
+	CountryID uint64
+	VenueID   uint64
+	Type      string
+	Gender    Gender
+	// Founded is the year the team was founded, or zero if unknown.
+	Founded int
+	Logo    string
+}
+
+type Round struct {
+	ID       uint64
+	Name     string
+	SeasonID uint64
+	Start    time.Time
+	End      time.Time
+}
+
+type Venue struct {
+	ID   uint64
+	Name string
+}
+
+// Fixture is a match between two teams. Competition, season, round and venue are zero values if the data
+// service does not return them.
+type Fixture struct {
+	ID          uint64
+	Competition Competition
+	Season      Season
+	HomeTeam    Team
+	AwayTeam    Team
+	Round       Round
+	Venue       Venue
+	KickOff     time.Time
+}
+
+// FixtureSearch filters the fixtures returned by a search. Zero fields are not filtered on.
+type FixtureSearch struct {
+	SeasonIDs []uint64
+	TeamID    uint64
+	// Before and After match fixtures kicking off before and after a time, exclusively.
+	Before time.Time
+	After  time.Time
+	Sort   FixtureSort
+	Limit  uint64
+}
+
+type Player struct {
+	ID            uint64
+	CountryID     uint64
+	NationalityID uint64
+	CommonName    string
+	FirstName     string
+	LastName      string
+	Name          string
+	DisplayName   string
+	ImagePath     string
+	Height        int
+	Weight        int
+	DateOfBirth   time.Time
+	Gender        Gender
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Score is the number of goals scored by each team in a fixture.
+type Score struct {
+	Home int
+	Away int
+}
+
+// ParseScore parses a score formatted as the data service does, such as "2-1".
+func ParseScore(s string) (Score, error) {
+	var score Score
+
+	if _, err := fmt.Sscanf(s, "%d-%d", &score.Home, &score.Away); err != nil {
+		return Score{}, fmt.Errorf("invalid score %q", s)
+	}
+
+	return score, nil
+}
+
+func (s Score) String() string {
+	return fmt.Sprintf("%d-%d", s.Home, s.Away)
+}
+
+type Goal struct {
+	ID       uint64
+	TeamID   uint64
+	PlayerID uint64
+	// AssistPlayerID is the ID of the player assisting the goal, or zero if unassisted.
+	AssistPlayerID uint64
+	Minute         int
+	// Score is the score of the fixture after the goal.
+	Score Score
+}
+
+type Card struct {
+	ID       uint64
+	TeamID   uint64
+	PlayerID uint64
+	Type     CardType
+	Minute   int
+}
+
+type FixtureEvents struct {
+	FixtureID uint64
+	Goals     []Goal
+	Cards     []Card
+}
+
+// Score returns the score of the fixture after its last goal.
+func (e FixtureEvents) Score() Score {
+	var score Score
+
+	for _, g := range e.Goals {
+		if g.Score.Home+g.Score.Away >= score.Home+score.Away {
+			score = g.Score
+		}
+	}
+
+	return score
+}
+
+type LineupPlayer struct {
+	PlayerID uint64
+	Position Position
+	// FormationPosition is the player's place in the formation, or zero for substitutes.
+	FormationPosition int
+	IsSubstitute      bool
+}
+
+type Lineup struct {
+	Start []LineupPlayer
+	Bench []LineupPlayer
+}
+
+type FixtureLineups struct {
+	Home Lineup
+	Away Lineup
+}
+
+type TeamAttacks struct {
+	Counter   int
+	Total     int
+	Dangerous int
+}
+
+type TeamCards struct {
+	Red       int
+	Yellow    int
+	YellowRed int
+}
+
+type TeamCrosses struct {
+	Total    int
+	Accurate int
+}
+
+type TeamDribbles struct {
+	Total   int
+	Success int
+}
+
+type TeamGoals struct {
+	Scored           int
+	ScoredHalfTime   int
+	Conceded         int
+	ConcededHalfTime int
+	Attempts         int
+}
+
+type TeamHeaders struct {
+	Total   int
+	Success int
+}
+
+type TeamPasses struct {
+	Total      int
+	Success    int
+	Percentage int
+	Key        int
+	Long       int
+}
+
+type TeamShots struct {
+	Total      int
+	OnGoal     int
+	OffGoal    int
+	Blocked    int
+	InsideBox  int
+	OutsideBox int
+}
+
+type TeamStats struct {
+	TeamID         uint64
+	FixtureID      uint64
+	Assists        int
+	BallPossession int
+	BallSafe       int
+	Challenges     int
+	Corners        int
+	DuelsWon       int
+	FreeKicks      int
+	Fouls          int
+	GoalKicks      int
+	HitWoodwork    int
+	Injuries       int
+	Interceptions  int
+	Offsides       int
+	Penalties      int
+	Saves          int
+	Substitutions  int
+	Tackles        int
+	Attacks        TeamAttacks
+	Cards          TeamCards
+	Crosses        TeamCrosses
+	Dribbles       TeamDribbles
+	Goals          TeamGoals
+	Headers        TeamHeaders
+	Passes         TeamPasses
+	Shots          TeamShots
+	ThrowIns       int
+	Treatments     int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type FixtureTeamStats struct {
+	Home TeamStats
+	Away TeamStats
+}
+
+type Shots struct {
+	Total     int
+	OnTarget  int
+	OffTarget int
+	Blocked   int
+	Stopped   int
+}
+
+type Goals struct {
+	Scored   int
+	Conceded int
+}
+
+type Fouls struct {
+	Drawn     int
+	Committed int
+}
+
+type GoalKeeper struct {
+	SavesTotal     int
+	SavesInsideBox int
+	GoalsConceded  int
+	Punches        int
+}
+
+type Penalties struct {
+	Scored    int
+	Missed    int
+	Saved     int
+	Committed int
+	Won       int
+}
+
+type Crosses struct {
+	Total    int
+	Accurate int
+}
+
+type Passes struct {
+	Total    int
+	Accurate int
+	Accuracy int
+	Key      int
+	Long     int
+}
+
+type ThroughBalls struct {
+	Total int
+	Won   int
+}
+
+type Duels struct {
+	Total int
+	Won   int
+	Lost  int
+}
+
+type Dribbles struct {
+	Attempts int
+	Success  int
+	Past     int
+}
+
+type PlayerStats struct {
+	PlayerID          uint64
+	SeasonID          uint64
+	TeamID            uint64
+	FixtureID         uint64
+	PlayerName        string
+	AerialsWon        int
+	Shots             Shots
+	Goals             Goals
+	Fouls             Fouls
+	GoalKeeper        GoalKeeper
+	BigChancesCreated int
+	BigChancesMissed  int
+	Assists           int
+	Dispossessed      int
+	YellowCards       int
+	RedCards          int
+	YellowRedCards    int
+	Penalties         Penalties
+	Crosses           Crosses
+	Passes            Passes
+	ThroughBalls      ThroughBalls
+	Duels             Duels
+	Dribbles          Dribbles
+	LongBallsWon      int
+	Offsides          int
+	HitWoodwork       int
+	Tackles           int
+	Interceptions     int
+	Clearances        int
+	ClearancesOffLine int
+	Touches           int
+	MinutesPlayed     int
+	Rating            float64
+	OwnGoals          int
+	ErrorLedToGoal    int
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type FixturePlayerStats struct {
+	Home []PlayerStats
+	Away []PlayerStats
+}
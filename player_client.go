@@ -13,29 +13,32 @@ type PlayerClient interface {
 
 type playerClient struct {
 	client statistico.PlayerServiceClient
+	opts   options
 }
 
 func (t *playerClient) ByID(ctx context.Context, id uint64) (*statistico.Player, error) {
 	req := statistico.PlayerRequest{PlayerId: id}
 
-	player, err := t.client.GetPlayerByID(ctx, &req)
-
-	if err != nil {
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.NotFound:
-				return nil, ErrorNotFound{ID: id, err: err}
-			default:
-				return nil, ErrorBadGateway{err}
+	return invokeUnary(ctx, t.opts, MethodPlayerByID, &req, func(ctx context.Context) (*statistico.Player, error) {
+		player, err := t.client.GetPlayerByID(ctx, &req)
+
+		if err != nil {
+			if e, ok := status.FromError(err); ok {
+				switch e.Code() {
+				case codes.NotFound:
+					return nil, ErrorNotFound{ID: id, err: err}
+				default:
+					return nil, ErrorBadGateway{err}
+				}
 			}
-		}
 
-		return nil, err
-	}
+			return nil, err
+		}
 
-	return player, nil
+		return player, nil
+	})
 }
 
-func NewPlayerClient(p statistico.PlayerServiceClient) PlayerClient {
-	return &playerClient{client: p}
+func NewPlayerClient(p statistico.PlayerServiceClient, opts ...Option) PlayerClient {
+	return &playerClient{client: p, opts: newOptions(opts)}
 }
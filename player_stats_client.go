@@ -13,29 +13,32 @@ type PlayerStatsClient interface {
 
 type playerStatsClient struct {
 	client statistico.PlayerStatsServiceClient
+	opts   options
 }
 
 func (p *playerStatsClient) FixtureStats(ctx context.Context, req *statistico.FixtureRequest) (*statistico.PlayerStatsResponse, error) {
-	res, err := p.client.GetPlayerStatsForFixture(ctx, req)
-
-	if err != nil {
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.InvalidArgument:
-				return nil, ErrorInvalidArgument{err}
-			case codes.Internal:
-				return nil, ErrorExternalServer{err}
-			default:
-				return nil, ErrorBadGateway{err}
+	return invokeUnary(ctx, p.opts, MethodPlayerStatsFixtureStats, req, func(ctx context.Context) (*statistico.PlayerStatsResponse, error) {
+		res, err := p.client.GetPlayerStatsForFixture(ctx, req)
+
+		if err != nil {
+			if e, ok := status.FromError(err); ok {
+				switch e.Code() {
+				case codes.InvalidArgument:
+					return nil, ErrorInvalidArgument{err}
+				case codes.Internal:
+					return nil, ErrorExternalServer{err}
+				default:
+					return nil, ErrorBadGateway{err}
+				}
 			}
-		}
 
-		return nil, err
-	}
+			return nil, err
+		}
 
-	return res, nil
+		return res, nil
+	})
 }
 
-func NewPlayerStatsClient(p statistico.PlayerStatsServiceClient) PlayerStatsClient {
-	return &playerStatsClient{client: p}
+func NewPlayerStatsClient(p statistico.PlayerStatsServiceClient, opts ...Option) PlayerStatsClient {
+	return &playerStatsClient{client: p, opts: newOptions(opts)}
 }
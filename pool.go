@@ -0,0 +1,183 @@
+package statisticofootballdata
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"sync"
+	"sync/atomic"
+)
+
+// WithPoolSize makes Dial create a Pool of n connections rather than a single connection.
+func WithPoolSize(n int) DialOption {
+	return func(o *dialOptions) {
+		o.poolSize = n
+	}
+}
+
+type pooledConn struct {
+	conn *grpc.ClientConn
+	load atomic.Int64
+}
+
+// Pool is a grpc.ClientConnInterface spreading calls across several connections to the same target,
+// avoiding the limit on concurrent streams over a single HTTP/2 connection. Each call is sent over the
+// connection with the fewest calls and streams in progress.
+type Pool struct {
+	conns []*pooledConn
+	mu    sync.Mutex
+}
+
+func (p *Pool) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	c := p.pick()
+	defer c.load.Add(-1)
+
+	return c.conn.Invoke(ctx, method, args, reply, opts...)
+}
+
+func (p *Pool) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	c := p.pick()
+
+	stream, err := c.conn.NewStream(ctx, desc, method, opts...)
+
+	if err != nil {
+		c.load.Add(-1)
+		return nil, err
+	}
+
+	return newPooledStream(ctx, stream, desc, c), nil
+}
+
+// pooledStream counts a stream against its connection until the stream finishes. The stream's Context
+// method is not used to detect this, as calling it commits the stream and prevents gRPC retrying it.
+type pooledStream struct {
+	grpc.ClientStream
+	desc *grpc.StreamDesc
+	done func()
+}
+
+func newPooledStream(ctx context.Context, stream grpc.ClientStream, desc *grpc.StreamDesc, c *pooledConn) *pooledStream {
+	var once sync.Once
+
+	release := func() {
+		once.Do(func() { c.load.Add(-1) })
+	}
+
+	stop := context.AfterFunc(ctx, release)
+
+	return &pooledStream{
+		ClientStream: stream,
+		desc:         desc,
+		done: func() {
+			stop()
+			release()
+		},
+	}
+}
+
+// RecvMsg releases the stream's connection once the stream has finished, when a message fails to be
+// received, including with io.EOF at the end of the stream, or when the single response of a stream
+// without server streaming has been received.
+func (s *pooledStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+
+	if err != nil || !s.desc.ServerStreams {
+		s.done()
+	}
+
+	return err
+}
+
+// pick returns the least loaded connection, counting the call about to be made against it.
+func (p *Pool) pick() *pooledConn {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	best := p.conns[0]
+
+	for _, c := range p.conns[1:] {
+		if c.load.Load() < best.load.Load() {
+			best = c
+		}
+	}
+
+	best.load.Add(1)
+
+	return best
+}
+
+// Load returns the number of calls and streams in progress over each connection.
+func (p *Pool) Load() []int64 {
+	load := make([]int64, len(p.conns))
+
+	for i, c := range p.conns {
+		load[i] = c.load.Load()
+	}
+
+	return load
+}
+
+// GetState returns the best connectivity state of the connections.
+func (p *Pool) GetState() connectivity.State {
+	return bestState(p.clientConns())
+}
+
+// WaitForStateChange waits until the state returned by GetState differs from state or ctx is done.
+func (p *Pool) WaitForStateChange(ctx context.Context, state connectivity.State) bool {
+	return waitForStateChange(ctx, p.clientConns(), state)
+}
+
+// Connect causes every connection to leave idle and connect.
+func (p *Pool) Connect() {
+	for _, c := range p.conns {
+		c.conn.Connect()
+	}
+}
+
+// Close closes every connection.
+func (p *Pool) Close() error {
+	var errs []error
+
+	for _, c := range p.conns {
+		errs = append(errs, c.conn.Close())
+	}
+
+	return errors.Join(errs...)
+}
+
+func (p *Pool) clientConns() []*grpc.ClientConn {
+	conns := make([]*grpc.ClientConn, len(p.conns))
+
+	for i, c := range p.conns {
+		conns[i] = c.conn
+	}
+
+	return conns
+}
+
+// NewPool creates a Pool of size connections to target.
+func NewPool(target string, size int, opts ...DialOption) (*Pool, error) {
+	if size < 1 {
+		return nil, errors.New("pool size must be at least one")
+	}
+
+	o := newDialOptions(opts)
+
+	p := &Pool{}
+
+	for i := 0; i < size; i++ {
+		t, grpcOpts := o.resolve(target)
+
+		conn, err := grpc.NewClient(t, grpcOpts...)
+
+		if err != nil {
+			p.Close()
+			return nil, err
+		}
+
+		p.conns = append(p.conns, &pooledConn{conn: conn})
+	}
+
+	return p, nil
+}
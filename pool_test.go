@@ -0,0 +1,307 @@
+package statisticofootballdata_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPool(t *testing.T) {
+	t.Run("spreads concurrent streams across connections", func(t *testing.T) {
+		t.Helper()
+
+		srv := startBlockingTeamServer(t)
+		defer srv.stop()
+
+		pool, err := statisticofootballdata.NewPool(srv.addr, 4)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer pool.Close()
+
+		client := statisticofootballdata.NewTeamClient(statistico.NewTeamServiceClient(pool))
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				_, err := client.BySeasonID(context.Background(), 16036)
+
+				assert.Nil(t, err)
+			}()
+		}
+
+		assert.Eventually(t, func() bool { return srv.streams() == 8 }, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, []int64{2, 2, 2, 2}, pool.Load())
+		assert.Equal(t, 4, len(srv.peers()))
+
+		close(srv.release)
+		wg.Wait()
+
+		assert.Eventually(t, func() bool {
+			for _, l := range pool.Load() {
+				if l != 0 {
+					return false
+				}
+			}
+
+			return true
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("sends calls over the least loaded connection", func(t *testing.T) {
+		t.Helper()
+
+		srv := startBlockingTeamServer(t)
+		defer srv.stop()
+
+		pool, err := statisticofootballdata.NewPool(srv.addr, 2)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer pool.Close()
+
+		client := statisticofootballdata.NewTeamClient(statistico.NewTeamServiceClient(pool))
+
+		done := make(chan struct{})
+
+		go func() {
+			_, _ = client.BySeasonID(context.Background(), 16036)
+			close(done)
+		}()
+
+		assert.Eventually(t, func() bool { return srv.streams() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+		for i := 0; i < 3; i++ {
+			_, err := client.ByID(context.Background(), 1)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+		}
+
+		assert.Equal(t, 2, len(srv.peers()))
+
+		close(srv.release)
+		<-done
+	})
+
+	t.Run("is used by Dial when pool size is set", func(t *testing.T) {
+		t.Helper()
+
+		srv := startBlockingTeamServer(t)
+		defer srv.stop()
+
+		client, err := statisticofootballdata.Dial(srv.addr, statisticofootballdata.WithPoolSize(3))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+				_, _ = client.Teams.BySeasonID(context.Background(), 16036)
+			}()
+		}
+
+		assert.Eventually(t, func() bool { return srv.streams() == 3 }, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, 3, len(srv.peers()))
+		assert.Eventually(t, client.Ready, 5*time.Second, 10*time.Millisecond)
+
+		close(srv.release)
+		wg.Wait()
+	})
+
+	t.Run("allows streams to be retried by a retry policy", func(t *testing.T) {
+		t.Helper()
+
+		srv := startBlockingTeamServer(t)
+		defer srv.stop()
+
+		close(srv.release)
+		srv.failures.Store(1)
+
+		pool, err := statisticofootballdata.NewPool(srv.addr, 2, statisticofootballdata.WithServiceConfig(`{
+			"methodConfig": [{
+				"name": [{"service": "statistico.TeamService"}],
+				"retryPolicy": {
+					"maxAttempts": 3,
+					"initialBackoff": "0.01s",
+					"maxBackoff": "0.01s",
+					"backoffMultiplier": 1,
+					"retryableStatusCodes": ["UNAVAILABLE"]
+				}
+			}]
+		}`))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer pool.Close()
+
+		client := statisticofootballdata.NewTeamClient(statistico.NewTeamServiceClient(pool))
+
+		teams, err := client.BySeasonID(context.Background(), 16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(teams))
+		assert.Equal(t, int32(0), srv.failures.Load())
+		assert.Equal(t, []int64{0, 0}, pool.Load())
+	})
+
+	t.Run("releases streams whose context is cancelled", func(t *testing.T) {
+		t.Helper()
+
+		srv := startBlockingTeamServer(t)
+		defer srv.stop()
+		defer close(srv.release)
+
+		pool, err := statisticofootballdata.NewPool(srv.addr, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer pool.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		_, err = statistico.NewTeamServiceClient(pool).GetTeamsBySeasonId(ctx, &statistico.SeasonTeamsRequest{SeasonId: 16036})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []int64{1}, pool.Load())
+
+		cancel()
+
+		assert.Eventually(t, func() bool { return pool.Load()[0] == 0 }, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("returns error if size is less than one", func(t *testing.T) {
+		t.Helper()
+
+		_, err := statisticofootballdata.NewPool("localhost:50051", 0)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+	})
+}
+
+// blockingTeamServer holds streams open until released, recording the client address of each call.
+type blockingTeamServer struct {
+	statistico.UnimplementedTeamServiceServer
+	addr    string
+	release chan struct{}
+	stop    func()
+
+	// failures is the number of streams to fail with Unavailable before blocking.
+	failures atomic.Int32
+
+	mu    sync.Mutex
+	open  int
+	calls map[string]int
+}
+
+func (s *blockingTeamServer) record(ctx context.Context) {
+	p, _ := peer.FromContext(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls[p.Addr.String()]++
+}
+
+func (s *blockingTeamServer) GetTeamByID(ctx context.Context, r *statistico.TeamRequest) (*statistico.Team, error) {
+	s.record(ctx)
+
+	return &statistico.Team{Id: r.GetTeamId()}, nil
+}
+
+func (s *blockingTeamServer) GetTeamsBySeasonId(_ *statistico.SeasonTeamsRequest, stream grpc.ServerStreamingServer[statistico.Team]) error {
+	if s.failures.Load() > 0 {
+		s.failures.Add(-1)
+		return status.Error(codes.Unavailable, "unavailable")
+	}
+
+	s.record(stream.Context())
+
+	s.mu.Lock()
+	s.open++
+	s.mu.Unlock()
+
+	<-s.release
+
+	return stream.Send(&statistico.Team{Id: 1})
+}
+
+func (s *blockingTeamServer) streams() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.open
+}
+
+func (s *blockingTeamServer) peers() map[string]int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	peers := map[string]int{}
+
+	for k, v := range s.calls {
+		peers[k] = v
+	}
+
+	return peers
+}
+
+func startBlockingTeamServer(t *testing.T) *blockingTeamServer {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("Expected nil, got %s", err.Error())
+	}
+
+	s := &blockingTeamServer{addr: lis.Addr().String(), release: make(chan struct{}), calls: map[string]int{}}
+
+	srv := grpc.NewServer()
+
+	statistico.RegisterTeamServiceServer(srv, s)
+
+	go srv.Serve(lis)
+
+	s.stop = srv.Stop
+
+	return s
+}
@@ -0,0 +1,93 @@
+// Package rediscache provides a statisticofootballdata.Cache backed by Redis, or any server speaking
+// the Redis protocol, so cached responses can be shared between processes and survive deploys.
+package rediscache
+
+import (
+	"context"
+	"errors"
+	"github.com/redis/go-redis/v9"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores entries in Redis under a key prefix.
+type Cache struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+func (c *Cache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	b, err := c.client.Get(ctx, c.prefix+key).Bytes()
+
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return b, true, nil
+}
+
+func (c *Cache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return c.client.Set(ctx, c.prefix+key, value, ttl).Err()
+}
+
+func (c *Cache) Delete(ctx context.Context, key string) error {
+	return c.client.Del(ctx, c.prefix+key).Err()
+}
+
+// Keys scans the database for keys beginning with prefix. Cluster and ring clients scan every master or
+// shard, as each holds only a share of the keys.
+func (c *Cache) Keys(ctx context.Context, prefix string) ([]string, error) {
+	match := escape(c.prefix+prefix) + "*"
+
+	var mu sync.Mutex
+
+	keys := []string{}
+
+	each := func(ctx context.Context, node *redis.Client) error {
+		found, err := c.scan(ctx, node, match)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		keys = append(keys, found...)
+
+		return err
+	}
+
+	switch client := c.client.(type) {
+	case *redis.ClusterClient:
+		return keys, client.ForEachMaster(ctx, each)
+	case *redis.Ring:
+		return keys, client.ForEachShard(ctx, each)
+	default:
+		return c.scan(ctx, client, match)
+	}
+}
+
+func (c *Cache) scan(ctx context.Context, client redis.Cmdable, match string) ([]string, error) {
+	keys := []string{}
+
+	iter := client.Scan(ctx, 0, match, 100).Iterator()
+
+	for iter.Next(ctx) {
+		keys = append(keys, strings.TrimPrefix(iter.Val(), c.prefix))
+	}
+
+	return keys, iter.Err()
+}
+
+// escape quotes the characters treated as special in Redis glob patterns.
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "]", `\]`).Replace(s)
+}
+
+// New creates a Cache storing entries using client, prefixing every key with prefix so the
+// database can be shared with other applications.
+func New(client redis.UniversalClient, prefix string) *Cache {
+	return &Cache{client: client, prefix: prefix}
+}
@@ -0,0 +1,167 @@
+package rediscache_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-football-data-go-grpc-client/rediscache"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func newCache(t *testing.T) (*rediscache.Cache, *miniredis.Miniredis) {
+	t.Helper()
+
+	srv := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: srv.Addr()})
+
+	t.Cleanup(func() { _ = client.Close() })
+
+	return rediscache.New(client, "statistico:"), srv
+}
+
+func TestCache(t *testing.T) {
+	var _ statisticofootballdata.Cache = (*rediscache.Cache)(nil)
+
+	t.Run("stores and returns values under the key prefix", func(t *testing.T) {
+		t.Helper()
+
+		cache, srv := newCache(t)
+		ctx := context.Background()
+
+		if err := cache.Set(ctx, "team/1", []byte("West Ham United"), time.Minute); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		value, ok, err := cache.Get(ctx, "team/1")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.True(t, ok)
+		assert.Equal(t, []byte("West Ham United"), value)
+		assert.True(t, srv.Exists("statistico:team/1"))
+		assert.Equal(t, time.Minute, srv.TTL("statistico:team/1"))
+	})
+
+	t.Run("reports a miss for missing and expired keys", func(t *testing.T) {
+		t.Helper()
+
+		cache, srv := newCache(t)
+		ctx := context.Background()
+
+		_ = cache.Set(ctx, "team/1", []byte("West Ham United"), time.Minute)
+		srv.FastForward(2 * time.Minute)
+
+		for _, key := range []string{"team/1", "team/2"} {
+			_, ok, err := cache.Get(ctx, key)
+
+			if err != nil {
+				t.Fatalf("Expected nil, got %s", err.Error())
+			}
+
+			assert.False(t, ok)
+		}
+	})
+
+	t.Run("deletes values", func(t *testing.T) {
+		t.Helper()
+
+		cache, _ := newCache(t)
+		ctx := context.Background()
+
+		_ = cache.Set(ctx, "team/1", []byte("West Ham United"), time.Minute)
+
+		if err := cache.Delete(ctx, "team/1"); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		_, ok, _ := cache.Get(ctx, "team/1")
+
+		assert.False(t, ok)
+	})
+
+	t.Run("lists keys beginning with a prefix", func(t *testing.T) {
+		t.Helper()
+
+		cache, srv := newCache(t)
+		ctx := context.Background()
+
+		_ = srv.Set("other:team/1/a", "a")
+		_ = cache.Set(ctx, "team/1/a", []byte("a"), time.Minute)
+		_ = cache.Set(ctx, "team/2/a", []byte("a"), time.Minute)
+		_ = cache.Set(ctx, "team/1*", []byte("a"), time.Minute)
+
+		keys, err := cache.Keys(ctx, "team/1/")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []string{"team/1/a"}, keys)
+	})
+
+	t.Run("lists keys held by every shard of a ring", func(t *testing.T) {
+		t.Helper()
+
+		client := redis.NewRing(&redis.RingOptions{Addrs: map[string]string{
+			"a": miniredis.RunT(t).Addr(),
+			"b": miniredis.RunT(t).Addr(),
+		}})
+
+		t.Cleanup(func() { _ = client.Close() })
+
+		cache := rediscache.New(client, "statistico:")
+		ctx := context.Background()
+
+		for i := 0; i < 20; i++ {
+			_ = cache.Set(ctx, fmt.Sprintf("team/%d", i), []byte("a"), time.Minute)
+		}
+
+		keys, err := cache.Keys(ctx, "team/")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 20, len(keys))
+	})
+
+	t.Run("lists keys held by a cluster", func(t *testing.T) {
+		t.Helper()
+
+		srv := miniredis.RunT(t)
+		client := redis.NewClusterClient(&redis.ClusterOptions{Addrs: []string{srv.Addr()}})
+
+		t.Cleanup(func() { _ = client.Close() })
+
+		cache := rediscache.New(client, "statistico:")
+		ctx := context.Background()
+
+		_ = cache.Set(ctx, "team/1/a", []byte("a"), time.Minute)
+		_ = cache.Set(ctx, "team/2/a", []byte("a"), time.Minute)
+
+		keys, err := cache.Keys(ctx, "team/1/")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []string{"team/1/a"}, keys)
+	})
+
+	t.Run("returns errors from the server", func(t *testing.T) {
+		t.Helper()
+
+		cache, srv := newCache(t)
+		srv.SetError("server unavailable")
+
+		_, _, err := cache.Get(context.Background(), "team/1")
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,60 @@
+package statisticofootballdata
+
+import (
+	statistico "github.com/statistico/statistico-proto/go"
+	"google.golang.org/protobuf/proto"
+)
+
+// Field is a field of a request identifying the resources the request is for, such as the fixture of a
+// FixtureRequest or the seasons of a FixtureSearchRequest.
+type Field struct {
+	// Name is the name of the field in snake case, e.g. fixture_id or season_ids.
+	Name string
+	// Kind is the kind of resource the field identifies.
+	Kind CacheKind
+	// IDs holds the value of the field, a single ID unless the field is Repeated.
+	IDs []uint64
+	// Repeated reports whether the field holds a list of IDs.
+	Repeated bool
+}
+
+// RequestFields returns the fields identifying the resources req is for, in the order they are declared
+// by the request message. Optional fields that are not set are omitted, and requests that identify no
+// resource return nil. The same fields scope cache entries and annotate spans and log records, so
+// supporting a new request type only requires adding it here.
+func RequestFields(req proto.Message) []Field {
+	one := func(name string, kind CacheKind, id uint64) Field {
+		return Field{Name: name, Kind: kind, IDs: []uint64{id}}
+	}
+
+	many := func(name string, kind CacheKind, ids []uint64) Field {
+		return Field{Name: name, Kind: kind, IDs: ids, Repeated: true}
+	}
+
+	switch r := req.(type) {
+	case *statistico.CompetitionRequest:
+		return []Field{many("country_ids", CacheKindCountry, r.GetCountryIds())}
+	case *statistico.FixtureRequest:
+		return []Field{one("fixture_id", CacheKindFixture, r.GetFixtureId())}
+	case *statistico.FixtureSearchRequest:
+		fields := []Field{many("season_ids", CacheKindSeason, r.GetSeasonIds())}
+
+		if r.GetTeamId() != nil {
+			fields = append(fields, one("team_id", CacheKindTeam, r.GetTeamId().GetValue()))
+		}
+
+		return fields
+	case *statistico.PlayerRequest:
+		return []Field{one("player_id", CacheKindPlayer, r.GetPlayerId())}
+	case *statistico.SeasonCompetitionRequest:
+		return []Field{one("competition_id", CacheKindCompetition, r.GetCompetitionId())}
+	case *statistico.SeasonTeamsRequest:
+		return []Field{one("season_id", CacheKindSeason, r.GetSeasonId())}
+	case *statistico.TeamRequest:
+		return []Field{one("team_id", CacheKindTeam, r.GetTeamId())}
+	case *statistico.TeamSeasonsRequest:
+		return []Field{one("team_id", CacheKindTeam, r.GetTeamId())}
+	}
+
+	return nil
+}
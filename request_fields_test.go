@@ -0,0 +1,96 @@
+package statisticofootballdata_test
+
+import (
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"testing"
+)
+
+func TestRequestFields(t *testing.T) {
+	tests := []struct {
+		name string
+		req  proto.Message
+		want []statisticofootballdata.Field
+	}{
+		{
+			name: "competition request",
+			req:  &statistico.CompetitionRequest{CountryIds: []uint64{462, 320}},
+			want: []statisticofootballdata.Field{
+				{Name: "country_ids", Kind: statisticofootballdata.CacheKindCountry, IDs: []uint64{462, 320}, Repeated: true},
+			},
+		},
+		{
+			name: "fixture request",
+			req:  &statistico.FixtureRequest{FixtureId: 192},
+			want: []statisticofootballdata.Field{
+				{Name: "fixture_id", Kind: statisticofootballdata.CacheKindFixture, IDs: []uint64{192}},
+			},
+		},
+		{
+			name: "fixture search request",
+			req:  &statistico.FixtureSearchRequest{SeasonIds: []uint64{16036}, TeamId: &wrapperspb.UInt64Value{Value: 1}},
+			want: []statisticofootballdata.Field{
+				{Name: "season_ids", Kind: statisticofootballdata.CacheKindSeason, IDs: []uint64{16036}, Repeated: true},
+				{Name: "team_id", Kind: statisticofootballdata.CacheKindTeam, IDs: []uint64{1}},
+			},
+		},
+		{
+			name: "fixture search request without a team",
+			req:  &statistico.FixtureSearchRequest{},
+			want: []statisticofootballdata.Field{
+				{Name: "season_ids", Kind: statisticofootballdata.CacheKindSeason, Repeated: true},
+			},
+		},
+		{
+			name: "player request",
+			req:  &statistico.PlayerRequest{PlayerId: 37},
+			want: []statisticofootballdata.Field{
+				{Name: "player_id", Kind: statisticofootballdata.CacheKindPlayer, IDs: []uint64{37}},
+			},
+		},
+		{
+			name: "season competition request",
+			req:  &statistico.SeasonCompetitionRequest{CompetitionId: 8},
+			want: []statisticofootballdata.Field{
+				{Name: "competition_id", Kind: statisticofootballdata.CacheKindCompetition, IDs: []uint64{8}},
+			},
+		},
+		{
+			name: "season teams request",
+			req:  &statistico.SeasonTeamsRequest{SeasonId: 16036},
+			want: []statisticofootballdata.Field{
+				{Name: "season_id", Kind: statisticofootballdata.CacheKindSeason, IDs: []uint64{16036}},
+			},
+		},
+		{
+			name: "team request",
+			req:  &statistico.TeamRequest{TeamId: 1},
+			want: []statisticofootballdata.Field{
+				{Name: "team_id", Kind: statisticofootballdata.CacheKindTeam, IDs: []uint64{1}},
+			},
+		},
+		{
+			name: "team seasons request",
+			req:  &statistico.TeamSeasonsRequest{TeamId: 1},
+			want: []statisticofootballdata.Field{
+				{Name: "team_id", Kind: statisticofootballdata.CacheKindTeam, IDs: []uint64{1}},
+			},
+		},
+		{
+			name: "message that is not a request",
+			req:  &statistico.Team{Id: 1},
+			want: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run("returns the fields of a "+tc.name, func(t *testing.T) {
+			t.Helper()
+
+			assert.Equal(t, tc.want, statisticofootballdata.RequestFields(tc.req))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package statisticofootballdata
+
+import "context"
+
+type responseInfoKey struct{}
+
+// ResponseInfo describes how a call was served. Attach one to the context passed to a client method
+// using WithResponseInfo and inspect it once the method returns.
+type ResponseInfo struct {
+	// Cached reports whether the response was served by a ResponseCache.
+	Cached bool
+	// Stale reports whether the cached response had passed its TTL.
+	Stale bool
+	// Endpoint is the target of the data service serving the call when made over a FailoverConn.
+	Endpoint string
+}
+
+func (i *ResponseInfo) served(stale bool) {
+	if i == nil {
+		return
+	}
+
+	i.Cached = true
+	i.Stale = stale
+}
+
+func (i *ResponseInfo) servedBy(endpoint string) {
+	if i == nil {
+		return
+	}
+
+	i.Endpoint = endpoint
+}
+
+// WithResponseInfo returns a copy of ctx carrying a ResponseInfo populated by calls made with it. A
+// ResponseInfo describes a single call so a new one should be attached for each call.
+func WithResponseInfo(ctx context.Context) (context.Context, *ResponseInfo) {
+	info := &ResponseInfo{}
+
+	return context.WithValue(ctx, responseInfoKey{}, info), info
+}
+
+func responseInfoFrom(ctx context.Context) *ResponseInfo {
+	info, _ := ctx.Value(responseInfoKey{}).(*ResponseInfo)
+
+	return info
+}
@@ -0,0 +1,34 @@
+package statisticofootballdata_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"testing"
+)
+
+func TestWithResponseInfo(t *testing.T) {
+	t.Run("records whether a call was served from the cache", func(t *testing.T) {
+		t.Helper()
+
+		m := new(MockProtoTeamClient)
+		client := statisticofootballdata.NewCachedTeamClient(
+			statisticofootballdata.NewTeamClient(m),
+			statisticofootballdata.NewResponseCache(),
+		)
+
+		first, firstInfo := statisticofootballdata.WithResponseInfo(context.Background())
+		second, secondInfo := statisticofootballdata.WithResponseInfo(context.Background())
+
+		m.On("GetTeamByID", first, &statistico.TeamRequest{TeamId: 1}, []grpc.CallOption(nil)).Return(&statistico.Team{Id: 1}, nil)
+
+		_, _ = client.ByID(first, 1)
+		_, _ = client.ByID(second, 1)
+
+		assert.False(t, firstInfo.Cached)
+		assert.True(t, secondInfo.Cached)
+		assert.False(t, secondInfo.Stale)
+	})
+}
@@ -16,6 +16,7 @@ type SeasonClient interface {
 
 type seasonClient struct {
 	client statistico.SeasonServiceClient
+	opts   options
 }
 
 func (s *seasonClient) ByTeamID(ctx context.Context, teamId uint64, sort string) ([]*statistico.Season, error) {
@@ -26,57 +27,69 @@ func (s *seasonClient) ByTeamID(ctx context.Context, teamId uint64, sort string)
 		Sort:   &wrapperspb.StringValue{Value: sort},
 	}
 
-	response, err := s.client.GetSeasonsForTeam(ctx, &req)
+	response, err := invokeUnary(ctx, s.opts, MethodSeasonByTeamID, &req, func(ctx context.Context) (*statistico.TeamSeasonsResponse, error) {
+		response, err := s.client.GetSeasonsForTeam(ctx, &req)
 
-	if err != nil {
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.Internal:
-				return seasons, ErrorExternalServer{err}
-			default:
-				return seasons, ErrorBadGateway{err}
+		if err != nil {
+			if e, ok := status.FromError(err); ok {
+				switch e.Code() {
+				case codes.Internal:
+					return nil, ErrorExternalServer{err}
+				default:
+					return nil, ErrorBadGateway{err}
+				}
 			}
 		}
+
+		return response, nil
+	})
+
+	if err != nil {
+		return seasons, err
 	}
 
 	return response.Seasons, nil
 }
 
 func (s *seasonClient) ByCompetitionID(ctx context.Context, competitionId uint64, sort string) ([]*statistico.Season, error) {
-	seasons := []*statistico.Season{}
-
 	req := statistico.SeasonCompetitionRequest{CompetitionId: competitionId, Sort: &wrapperspb.StringValue{Value: sort}}
 
-	stream, err := s.client.GetSeasonsForCompetition(ctx, &req)
+	return invokeStream(ctx, s.opts, MethodSeasonByCompetitionID, &req, func(ctx context.Context, call *Call) ([]*statistico.Season, error) {
+		seasons := []*statistico.Season{}
 
-	if err != nil {
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.Internal:
-				return seasons, ErrorExternalServer{err}
-			default:
-				return seasons, ErrorBadGateway{err}
+		stream, err := s.client.GetSeasonsForCompetition(ctx, &req)
+
+		if err != nil {
+			if e, ok := status.FromError(err); ok {
+				switch e.Code() {
+				case codes.Internal:
+					return seasons, ErrorExternalServer{err}
+				default:
+					return seasons, ErrorBadGateway{err}
+				}
 			}
 		}
-	}
 
-	for {
-		season, err := stream.Recv()
+		for {
+			season, err := stream.Recv()
 
-		if err == io.EOF {
-			break
-		}
+			if err == io.EOF {
+				break
+			}
 
-		if err != nil {
-			return seasons, ErrorExternalServer{err}
-		}
+			if err != nil {
+				return seasons, ErrorExternalServer{err}
+			}
 
-		seasons = append(seasons, season)
-	}
+			call.received(season)
+
+			seasons = append(seasons, season)
+		}
 
-	return seasons, nil
+		return seasons, nil
+	})
 }
 
-func NewSeasonClient(c statistico.SeasonServiceClient) SeasonClient {
-	return &seasonClient{client: c}
+func NewSeasonClient(c statistico.SeasonServiceClient, opts ...Option) SeasonClient {
+	return &seasonClient{client: c, opts: newOptions(opts)}
 }
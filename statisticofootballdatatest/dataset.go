@@ -0,0 +1,575 @@
+// Package statisticofootballdatatest provides utilities for testing code using the data service
+// clients, including an in-process data service serving a Dataset over gRPC.
+package statisticofootballdatatest
+
+import (
+	"encoding/json"
+	"fmt"
+	statistico "github.com/statistico/statistico-proto/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"slices"
+	"sort"
+	"time"
+)
+
+// Dataset holds the data served by a fake data service. Teams and seasons are related to seasons and
+// competitions through the fixtures they play in, and additionally through SeasonTeams and
+// CompetitionSeasons for data without fixtures. Events, stats and line ups are keyed by fixture ID.
+//
+// Datasets can be encoded as JSON, with messages encoded using the protobuf JSON mapping:
+//
+//	{
+//	  "competitions": [{"id": "8", "name": "Premier League", "countryId": "462"}],
+//	  "seasons": [{"id": "16036", "name": "2019/2020"}],
+//	  "teams": [{"id": "1", "name": "West Ham United"}],
+//	  "fixtures": [{"id": "192", "season": {"id": "16036"}, "homeTeam": {"id": "1"}, ...}],
+//	  "events": {"192": {"fixtureId": "192", "goals": [...]}},
+//	  "seasonTeams": {"16036": [1, 2]}
+//	}
+type Dataset struct {
+	Competitions       []*statistico.Competition
+	Seasons            []*statistico.Season
+	Teams              []*statistico.Team
+	Fixtures           []*statistico.Fixture
+	Players            []*statistico.Player
+	Events             map[uint64]*statistico.FixtureEventsResponse
+	PlayerStats        map[uint64]*statistico.PlayerStatsResponse
+	TeamStats          map[uint64]*statistico.TeamStatsResponse
+	Lineups            map[uint64]*statistico.LineupResponse
+	SeasonTeams        map[uint64][]uint64
+	CompetitionSeasons map[uint64][]uint64
+}
+
+type datasetJSON struct {
+	Competitions       []json.RawMessage          `json:"competitions,omitempty"`
+	Seasons            []json.RawMessage          `json:"seasons,omitempty"`
+	Teams              []json.RawMessage          `json:"teams,omitempty"`
+	Fixtures           []json.RawMessage          `json:"fixtures,omitempty"`
+	Players            []json.RawMessage          `json:"players,omitempty"`
+	Events             map[uint64]json.RawMessage `json:"events,omitempty"`
+	PlayerStats        map[uint64]json.RawMessage `json:"playerStats,omitempty"`
+	TeamStats          map[uint64]json.RawMessage `json:"teamStats,omitempty"`
+	Lineups            map[uint64]json.RawMessage `json:"lineups,omitempty"`
+	SeasonTeams        map[uint64][]uint64        `json:"seasonTeams,omitempty"`
+	CompetitionSeasons map[uint64][]uint64        `json:"competitionSeasons,omitempty"`
+}
+
+func (d *Dataset) UnmarshalJSON(b []byte) error {
+	var raw datasetJSON
+
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	var err error
+
+	ds := Dataset{SeasonTeams: raw.SeasonTeams, CompetitionSeasons: raw.CompetitionSeasons}
+
+	if ds.Competitions, err = decodeList[*statistico.Competition](raw.Competitions, "competitions"); err != nil {
+		return err
+	}
+
+	if ds.Seasons, err = decodeList[*statistico.Season](raw.Seasons, "seasons"); err != nil {
+		return err
+	}
+
+	if ds.Teams, err = decodeList[*statistico.Team](raw.Teams, "teams"); err != nil {
+		return err
+	}
+
+	if ds.Fixtures, err = decodeList[*statistico.Fixture](raw.Fixtures, "fixtures"); err != nil {
+		return err
+	}
+
+	if ds.Players, err = decodeList[*statistico.Player](raw.Players, "players"); err != nil {
+		return err
+	}
+
+	if ds.Events, err = decodeMap[*statistico.FixtureEventsResponse](raw.Events, "events"); err != nil {
+		return err
+	}
+
+	if ds.PlayerStats, err = decodeMap[*statistico.PlayerStatsResponse](raw.PlayerStats, "playerStats"); err != nil {
+		return err
+	}
+
+	if ds.TeamStats, err = decodeMap[*statistico.TeamStatsResponse](raw.TeamStats, "teamStats"); err != nil {
+		return err
+	}
+
+	if ds.Lineups, err = decodeMap[*statistico.LineupResponse](raw.Lineups, "lineups"); err != nil {
+		return err
+	}
+
+	*d = ds
+
+	return nil
+}
+
+func (d Dataset) MarshalJSON() ([]byte, error) {
+	raw := datasetJSON{SeasonTeams: d.SeasonTeams, CompetitionSeasons: d.CompetitionSeasons}
+
+	var err error
+
+	if raw.Competitions, err = encodeList(d.Competitions); err != nil {
+		return nil, err
+	}
+
+	if raw.Seasons, err = encodeList(d.Seasons); err != nil {
+		return nil, err
+	}
+
+	if raw.Teams, err = encodeList(d.Teams); err != nil {
+		return nil, err
+	}
+
+	if raw.Fixtures, err = encodeList(d.Fixtures); err != nil {
+		return nil, err
+	}
+
+	if raw.Players, err = encodeList(d.Players); err != nil {
+		return nil, err
+	}
+
+	if raw.Events, err = encodeMap(d.Events); err != nil {
+		return nil, err
+	}
+
+	if raw.PlayerStats, err = encodeMap(d.PlayerStats); err != nil {
+		return nil, err
+	}
+
+	if raw.TeamStats, err = encodeMap(d.TeamStats); err != nil {
+		return nil, err
+	}
+
+	if raw.Lineups, err = encodeMap(d.Lineups); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(raw)
+}
+
+func newMessage[T proto.Message]() T {
+	var zero T
+
+	return zero.ProtoReflect().Type().New().Interface().(T)
+}
+
+func decodeList[T proto.Message](raw []json.RawMessage, field string) ([]T, error) {
+	res := make([]T, len(raw))
+
+	for i, r := range raw {
+		m := newMessage[T]()
+
+		if err := protojson.Unmarshal(r, m); err != nil {
+			return nil, fmt.Errorf("decoding %s[%d]: %w", field, i, err)
+		}
+
+		res[i] = m
+	}
+
+	return res, nil
+}
+
+func decodeMap[T proto.Message](raw map[uint64]json.RawMessage, field string) (map[uint64]T, error) {
+	res := make(map[uint64]T, len(raw))
+
+	for id, r := range raw {
+		m := newMessage[T]()
+
+		if err := protojson.Unmarshal(r, m); err != nil {
+			return nil, fmt.Errorf("decoding %s[%d]: %w", field, id, err)
+		}
+
+		res[id] = m
+	}
+
+	return res, nil
+}
+
+func encodeList[T proto.Message](msgs []T) ([]json.RawMessage, error) {
+	res := make([]json.RawMessage, len(msgs))
+
+	for i, m := range msgs {
+		b, err := protojson.Marshal(m)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res[i] = b
+	}
+
+	return res, nil
+}
+
+func encodeMap[T proto.Message](msgs map[uint64]T) (map[uint64]json.RawMessage, error) {
+	res := make(map[uint64]json.RawMessage, len(msgs))
+
+	for id, m := range msgs {
+		b, err := protojson.Marshal(m)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res[id] = b
+	}
+
+	return res, nil
+}
+
+func notFound(kind string, id uint64) error {
+	return status.Errorf(codes.NotFound, "%s with ID %d does not exist", kind, id)
+}
+
+func (d *Dataset) team(id uint64) (*statistico.Team, error) {
+	for _, t := range d.Teams {
+		if t.GetId() == id {
+			return t, nil
+		}
+	}
+
+	return nil, notFound("team", id)
+}
+
+func (d *Dataset) fixture(id uint64) (*statistico.Fixture, error) {
+	for _, f := range d.Fixtures {
+		if uint64(f.GetId()) == id {
+			return f, nil
+		}
+	}
+
+	return nil, notFound("fixture", id)
+}
+
+func (d *Dataset) player(id uint64) (*statistico.Player, error) {
+	for _, p := range d.Players {
+		if p.GetId() == id {
+			return p, nil
+		}
+	}
+
+	return nil, notFound("player", id)
+}
+
+// teamsBySeason returns the teams playing in a season, in dataset order.
+func (d *Dataset) teamsBySeason(seasonID uint64) []*statistico.Team {
+	ids := map[uint64]bool{}
+
+	for _, id := range d.SeasonTeams[seasonID] {
+		ids[id] = true
+	}
+
+	for _, f := range d.Fixtures {
+		if f.GetSeason().GetId() == seasonID {
+			ids[f.GetHomeTeam().GetId()] = true
+			ids[f.GetAwayTeam().GetId()] = true
+		}
+	}
+
+	return d.teamsIn(ids)
+}
+
+// teamsByCompetitions returns the teams playing in fixtures of the competitions, in dataset order.
+func (d *Dataset) teamsByCompetitions(competitionIDs []uint64) []*statistico.Team {
+	ids := map[uint64]bool{}
+
+	for _, f := range d.Fixtures {
+		if slices.Contains(competitionIDs, f.GetCompetition().GetId()) {
+			ids[f.GetHomeTeam().GetId()] = true
+			ids[f.GetAwayTeam().GetId()] = true
+		}
+	}
+
+	return d.teamsIn(ids)
+}
+
+func (d *Dataset) teamsIn(ids map[uint64]bool) []*statistico.Team {
+	teams := []*statistico.Team{}
+
+	for _, t := range d.Teams {
+		if ids[t.GetId()] {
+			teams = append(teams, t)
+		}
+	}
+
+	return teams
+}
+
+// seasonsForCompetition returns the seasons of a competition sorted by name_asc or name_desc, or in
+// dataset order.
+func (d *Dataset) seasonsForCompetition(competitionID uint64, sort string) ([]*statistico.Season, error) {
+	ids := map[uint64]bool{}
+
+	for _, id := range d.CompetitionSeasons[competitionID] {
+		ids[id] = true
+	}
+
+	for _, f := range d.Fixtures {
+		if f.GetCompetition().GetId() == competitionID {
+			ids[f.GetSeason().GetId()] = true
+		}
+	}
+
+	return d.seasonsIn(ids, sort)
+}
+
+// seasonsForTeam returns the seasons a team plays in sorted by name_asc or name_desc, or in dataset
+// order.
+func (d *Dataset) seasonsForTeam(teamID uint64, sort string) ([]*statistico.Season, error) {
+	ids := map[uint64]bool{}
+
+	for season, teams := range d.SeasonTeams {
+		if slices.Contains(teams, teamID) {
+			ids[season] = true
+		}
+	}
+
+	for _, f := range d.Fixtures {
+		if f.GetHomeTeam().GetId() == teamID || f.GetAwayTeam().GetId() == teamID {
+			ids[f.GetSeason().GetId()] = true
+		}
+	}
+
+	return d.seasonsIn(ids, sort)
+}
+
+func (d *Dataset) seasonsIn(ids map[uint64]bool, order string) ([]*statistico.Season, error) {
+	seasons := []*statistico.Season{}
+
+	for _, s := range d.Seasons {
+		if ids[s.GetId()] {
+			seasons = append(seasons, s)
+		}
+	}
+
+	switch order {
+	case "":
+	case "name_asc":
+		sort.SliceStable(seasons, func(i, j int) bool { return seasons[i].GetName() < seasons[j].GetName() })
+	case "name_desc":
+		sort.SliceStable(seasons, func(i, j int) bool { return seasons[i].GetName() > seasons[j].GetName() })
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "sort %q is not supported", order)
+	}
+
+	return seasons, nil
+}
+
+// competitions returns the competitions of the countries, or every competition if none are given,
+// sorted by id_asc or id_desc, or in dataset order.
+func (d *Dataset) competitions(countryIDs []uint64, order string) ([]*statistico.Competition, error) {
+	competitions := []*statistico.Competition{}
+
+	for _, c := range d.Competitions {
+		if len(countryIDs) == 0 || slices.Contains(countryIDs, c.GetCountryId()) {
+			competitions = append(competitions, c)
+		}
+	}
+
+	switch order {
+	case "":
+	case "id_asc":
+		sort.SliceStable(competitions, func(i, j int) bool { return competitions[i].GetId() < competitions[j].GetId() })
+	case "id_desc":
+		sort.SliceStable(competitions, func(i, j int) bool { return competitions[i].GetId() > competitions[j].GetId() })
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "sort %q is not supported", order)
+	}
+
+	return competitions, nil
+}
+
+// search returns the fixtures matching a search, as the data service does. Dates must be RFC3339
+// formatted and results are sorted by date_asc, the default, or date_desc.
+func (d *Dataset) search(req *statistico.FixtureSearchRequest) ([]*statistico.Fixture, error) {
+	before, err := parseDate(req.GetDateBefore().GetValue(), "date_before")
+
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := parseDate(req.GetDateAfter().GetValue(), "date_after")
+
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures := []*statistico.Fixture{}
+
+	for _, f := range d.Fixtures {
+		if len(req.GetSeasonIds()) > 0 && !slices.Contains(req.GetSeasonIds(), f.GetSeason().GetId()) {
+			continue
+		}
+
+		if req.GetTeamId() != nil {
+			team := req.GetTeamId().GetValue()
+
+			if f.GetHomeTeam().GetId() != team && f.GetAwayTeam().GetId() != team {
+				continue
+			}
+		}
+
+		if before != nil && f.GetDateTime().GetUtc() >= before.Unix() {
+			continue
+		}
+
+		if after != nil && f.GetDateTime().GetUtc() <= after.Unix() {
+			continue
+		}
+
+		fixtures = append(fixtures, f)
+	}
+
+	switch req.GetSort().GetValue() {
+	case "", "date_asc":
+		sort.SliceStable(fixtures, func(i, j int) bool {
+			return fixtures[i].GetDateTime().GetUtc() < fixtures[j].GetDateTime().GetUtc()
+		})
+	case "date_desc":
+		sort.SliceStable(fixtures, func(i, j int) bool {
+			return fixtures[i].GetDateTime().GetUtc() > fixtures[j].GetDateTime().GetUtc()
+		})
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "sort %q is not supported", req.GetSort().GetValue())
+	}
+
+	if req.GetLimit() != nil && uint64(len(fixtures)) > req.GetLimit().GetValue() {
+		fixtures = fixtures[:req.GetLimit().GetValue()]
+	}
+
+	return fixtures, nil
+}
+
+// seasonFixtures returns the fixtures of a season kicking off between optional RFC3339 dates, sorted by
+// date.
+func (d *Dataset) seasonFixtures(req *statistico.SeasonFixtureRequest) ([]*statistico.Fixture, error) {
+	from, err := parseDate(req.GetDateFrom(), "date_from")
+
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := parseDate(req.GetDateTo(), "date_to")
+
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures := []*statistico.Fixture{}
+
+	for _, f := range d.Fixtures {
+		if f.GetSeason().GetId() != req.GetSeasonId() {
+			continue
+		}
+
+		if from != nil && f.GetDateTime().GetUtc() < from.Unix() {
+			continue
+		}
+
+		if to != nil && f.GetDateTime().GetUtc() > to.Unix() {
+			continue
+		}
+
+		fixtures = append(fixtures, f)
+	}
+
+	sort.SliceStable(fixtures, func(i, j int) bool {
+		return fixtures[i].GetDateTime().GetUtc() < fixtures[j].GetDateTime().GetUtc()
+	})
+
+	return fixtures, nil
+}
+
+func parseDate(value, field string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s must be an RFC3339 formatted date", field)
+	}
+
+	return &t, nil
+}
+
+func (d *Dataset) events(fixtureID uint64) (*statistico.FixtureEventsResponse, error) {
+	if _, err := d.fixture(fixtureID); err != nil {
+		return nil, err
+	}
+
+	if e, ok := d.Events[fixtureID]; ok {
+		return e, nil
+	}
+
+	return &statistico.FixtureEventsResponse{FixtureId: fixtureID}, nil
+}
+
+func (d *Dataset) playerStats(fixtureID uint64) (*statistico.PlayerStatsResponse, error) {
+	if _, err := d.fixture(fixtureID); err != nil {
+		return nil, err
+	}
+
+	if s, ok := d.PlayerStats[fixtureID]; ok {
+		return s, nil
+	}
+
+	return &statistico.PlayerStatsResponse{}, nil
+}
+
+func (d *Dataset) teamStats(fixtureID uint64) (*statistico.TeamStatsResponse, error) {
+	if _, err := d.fixture(fixtureID); err != nil {
+		return nil, err
+	}
+
+	if s, ok := d.TeamStats[fixtureID]; ok {
+		return s, nil
+	}
+
+	return &statistico.TeamStatsResponse{}, nil
+}
+
+func (d *Dataset) lineup(fixtureID uint64) (*statistico.LineupResponse, error) {
+	if _, err := d.fixture(fixtureID); err != nil {
+		return nil, err
+	}
+
+	if l, ok := d.Lineups[fixtureID]; ok {
+		return l, nil
+	}
+
+	return &statistico.LineupResponse{}, nil
+}
+
+// teamSeasonPlayerStats returns the stats of each player of a team in each fixture of a season,
+// ordered by fixture ID.
+func (d *Dataset) teamSeasonPlayerStats(teamID, seasonID uint64) []*statistico.PlayerStats {
+	fixtures := make([]uint64, 0, len(d.PlayerStats))
+
+	for id := range d.PlayerStats {
+		fixtures = append(fixtures, id)
+	}
+
+	slices.Sort(fixtures)
+
+	stats := []*statistico.PlayerStats{}
+
+	for _, id := range fixtures {
+		res := d.PlayerStats[id]
+
+		for _, s := range append(slices.Clone(res.GetHomeTeam()), res.GetAwayTeam()...) {
+			if s.GetTeamId() == teamID && s.GetSeasonId() == seasonID {
+				stats = append(stats, s)
+			}
+		}
+	}
+
+	return stats
+}
@@ -0,0 +1,125 @@
+package statisticofootballdatatest_test
+
+import (
+	"encoding/json"
+	"github.com/statistico/statistico-football-data-go-grpc-client/statisticofootballdatatest"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadDataset(t *testing.T) {
+	t.Run("loads a JSON encoded dataset", func(t *testing.T) {
+		t.Helper()
+
+		ds, err := statisticofootballdatatest.LoadDataset("testdata/dataset.json")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 3, len(ds.Competitions))
+		assert.Equal(t, 4, len(ds.Fixtures))
+		assert.Equal(t, "Chelsea", ds.Fixtures[0].GetAwayTeam().GetName())
+		assert.Equal(t, int64(1568473200), ds.Fixtures[0].GetDateTime().GetUtc())
+		assert.Equal(t, "1-0", ds.Events[192].GetGoals()[0].GetScore())
+		assert.Equal(t, []uint64{17420}, ds.CompetitionSeasons[24])
+	})
+
+	t.Run("returns an error for an invalid message", func(t *testing.T) {
+		t.Helper()
+
+		ds := statisticofootballdatatest.Dataset{}
+
+		err := json.Unmarshal([]byte(`{"teams": [{"id": "1"}, {"colour": "claret"}]}`), &ds)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Contains(t, err.Error(), "decoding teams[1]")
+	})
+
+	t.Run("encodes a dataset that decodes to the same data", func(t *testing.T) {
+		t.Helper()
+
+		ds := statisticofootballdatatest.Dataset{
+			Teams:       []*statistico.Team{{Id: 1, Name: "West Ham United"}},
+			Events:      map[uint64]*statistico.FixtureEventsResponse{192: {FixtureId: 192}},
+			SeasonTeams: map[uint64][]uint64{16036: {1}},
+		}
+
+		b, err := json.Marshal(ds)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		decoded := statisticofootballdatatest.Dataset{}
+
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.True(t, proto.Equal(ds.Teams[0], decoded.Teams[0]))
+		assert.True(t, proto.Equal(ds.Events[192], decoded.Events[192]))
+		assert.Equal(t, ds.SeasonTeams, decoded.SeasonTeams)
+	})
+}
+
+func TestLoadDatasetDir(t *testing.T) {
+	t.Run("merges the JSON and YAML files in a directory", func(t *testing.T) {
+		t.Helper()
+
+		dir := t.TempDir()
+
+		writeFile(t, filepath.Join(dir, "a.json"), `{"teams": [{"id": "1", "name": "West Ham United"}], "events": {"192": {"fixtureId": "192"}}}`)
+		writeFile(t, filepath.Join(dir, "b.yaml"), `
+teams:
+  - id: 18
+    name: Chelsea
+events:
+  193:
+    fixtureId: 193
+seasonTeams:
+  16036: [1, 18]
+`)
+		writeFile(t, filepath.Join(dir, "notes.txt"), "not data")
+
+		ds, err := statisticofootballdatatest.LoadDatasetDir(dir)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []string{"West Ham United", "Chelsea"}, []string{ds.Teams[0].GetName(), ds.Teams[1].GetName()})
+		assert.Equal(t, 2, len(ds.Events))
+		assert.Equal(t, uint64(193), ds.Events[193].GetFixtureId())
+		assert.Equal(t, []uint64{1, 18}, ds.SeasonTeams[16036])
+	})
+
+	t.Run("returns an error naming an invalid file", func(t *testing.T) {
+		t.Helper()
+
+		dir := t.TempDir()
+
+		writeFile(t, filepath.Join(dir, "teams.yml"), "teams: [{id: 1, colour: claret}]")
+
+		_, err := statisticofootballdatatest.LoadDatasetDir(dir)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Contains(t, err.Error(), "teams.yml")
+	})
+}
+
+func writeFile(t *testing.T, path, content string) {
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("Expected nil, got %s", err.Error())
+	}
+}
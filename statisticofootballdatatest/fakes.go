@@ -0,0 +1,262 @@
+package statisticofootballdatatest
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	statistico "github.com/statistico/statistico-proto/go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"io"
+)
+
+// NewCompetitionClient returns a CompetitionClient serving ds in memory, without gRPC. Like the other
+// fakes it wraps the real client, so requests are handled and errors returned as by the data service
+// and opts such as WithMiddleware apply as normal. Returned messages are copies, so can be modified.
+func NewCompetitionClient(ds *Dataset, opts ...statisticofootballdata.Option) statisticofootballdata.CompetitionClient {
+	return statisticofootballdata.NewCompetitionClient(competitionServiceClient{competitionServer{s: NewService(ds)}}, opts...)
+}
+
+// NewEventClient returns an EventClient serving ds in memory, without gRPC.
+func NewEventClient(ds *Dataset, opts ...statisticofootballdata.Option) statisticofootballdata.EventClient {
+	return statisticofootballdata.NewEventClient(eventServiceClient{eventServer{s: NewService(ds)}}, opts...)
+}
+
+// NewFixtureClient returns a FixtureClient serving ds in memory, without gRPC.
+func NewFixtureClient(ds *Dataset, opts ...statisticofootballdata.Option) statisticofootballdata.FixtureClient {
+	return statisticofootballdata.NewFixtureClient(fixtureServiceClient{fixtureServer{s: NewService(ds)}}, opts...)
+}
+
+// NewPlayerClient returns a PlayerClient serving ds in memory, without gRPC.
+func NewPlayerClient(ds *Dataset, opts ...statisticofootballdata.Option) statisticofootballdata.PlayerClient {
+	return statisticofootballdata.NewPlayerClient(playerServiceClient{playerServer{s: NewService(ds)}}, opts...)
+}
+
+// NewPlayerStatsClient returns a PlayerStatsClient serving ds in memory, without gRPC.
+func NewPlayerStatsClient(ds *Dataset, opts ...statisticofootballdata.Option) statisticofootballdata.PlayerStatsClient {
+	return statisticofootballdata.NewPlayerStatsClient(playerStatsServiceClient{playerStatsServer{s: NewService(ds)}}, opts...)
+}
+
+// NewSeasonClient returns a SeasonClient serving ds in memory, without gRPC.
+func NewSeasonClient(ds *Dataset, opts ...statisticofootballdata.Option) statisticofootballdata.SeasonClient {
+	return statisticofootballdata.NewSeasonClient(seasonServiceClient{seasonServer{s: NewService(ds)}}, opts...)
+}
+
+// NewTeamClient returns a TeamClient serving ds in memory, without gRPC.
+func NewTeamClient(ds *Dataset, opts ...statisticofootballdata.Option) statisticofootballdata.TeamClient {
+	return statisticofootballdata.NewTeamClient(teamServiceClient{teamServer{s: NewService(ds)}}, opts...)
+}
+
+// NewTeamStatClient returns a TeamStatClient serving ds in memory, without gRPC.
+func NewTeamStatClient(ds *Dataset, opts ...statisticofootballdata.Option) statisticofootballdata.TeamStatClient {
+	return statisticofootballdata.NewTeamStatClient(teamStatsServiceClient{teamStatsServer{s: NewService(ds)}}, opts...)
+}
+
+func clone[T any](m *T) *T {
+	return any(proto.Clone(any(m).(proto.Message))).(*T)
+}
+
+// unary handles a unary call in memory, failing it if ctx is done as gRPC would.
+func unary[T any](ctx context.Context, handle func() (*T, error)) (*T, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	res, err := handle()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return clone(res), nil
+}
+
+// serverStream handles a server streaming call in memory. Like a gRPC stream, errors returned by the
+// handler are received from the stream after the items sent before them.
+func serverStream[T any](ctx context.Context, handle func(grpc.ServerStreamingServer[T]) error) (grpc.ServerStreamingClient[T], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	c := &collector[T]{ctx: ctx}
+
+	err := handle(c)
+
+	return &clientStream[T]{ctx: ctx, items: c.items, err: err}, nil
+}
+
+// collector receives the items sent by a server streaming handler.
+type collector[T any] struct {
+	grpc.ServerStream
+	ctx   context.Context
+	items []*T
+}
+
+func (c *collector[T]) Send(m *T) error {
+	c.items = append(c.items, clone(m))
+	return nil
+}
+
+func (c *collector[T]) Context() context.Context {
+	return c.ctx
+}
+
+// clientStream returns the items collected from a server streaming handler, followed by its error or
+// io.EOF.
+type clientStream[T any] struct {
+	grpc.ClientStream
+	ctx   context.Context
+	items []*T
+	err   error
+}
+
+func (s *clientStream[T]) Recv() (*T, error) {
+	if err := s.ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	if len(s.items) > 0 {
+		item := s.items[0]
+		s.items = s.items[1:]
+
+		return item, nil
+	}
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return nil, io.EOF
+}
+
+func (s *clientStream[T]) Header() (metadata.MD, error) {
+	return metadata.MD{}, nil
+}
+
+func (s *clientStream[T]) Trailer() metadata.MD {
+	return metadata.MD{}
+}
+
+func (s *clientStream[T]) CloseSend() error {
+	return nil
+}
+
+func (s *clientStream[T]) Context() context.Context {
+	return s.ctx
+}
+
+type competitionServiceClient struct {
+	srv competitionServer
+}
+
+func (c competitionServiceClient) ListCompetitions(ctx context.Context, in *statistico.CompetitionRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Competition], error) {
+	return serverStream(ctx, func(s grpc.ServerStreamingServer[statistico.Competition]) error {
+		return c.srv.ListCompetitions(in, s)
+	})
+}
+
+type eventServiceClient struct {
+	srv eventServer
+}
+
+func (e eventServiceClient) FixtureEvents(ctx context.Context, in *statistico.FixtureRequest, _ ...grpc.CallOption) (*statistico.FixtureEventsResponse, error) {
+	return unary(ctx, func() (*statistico.FixtureEventsResponse, error) { return e.srv.FixtureEvents(ctx, in) })
+}
+
+type fixtureServiceClient struct {
+	srv fixtureServer
+}
+
+func (f fixtureServiceClient) ListSeasonFixtures(ctx context.Context, in *statistico.SeasonFixtureRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Fixture], error) {
+	return serverStream(ctx, func(s grpc.ServerStreamingServer[statistico.Fixture]) error {
+		return f.srv.ListSeasonFixtures(in, s)
+	})
+}
+
+func (f fixtureServiceClient) FixtureByID(ctx context.Context, in *statistico.FixtureRequest, _ ...grpc.CallOption) (*statistico.Fixture, error) {
+	return unary(ctx, func() (*statistico.Fixture, error) { return f.srv.FixtureByID(ctx, in) })
+}
+
+func (f fixtureServiceClient) Search(ctx context.Context, in *statistico.FixtureSearchRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Fixture], error) {
+	return serverStream(ctx, func(s grpc.ServerStreamingServer[statistico.Fixture]) error {
+		return f.srv.Search(in, s)
+	})
+}
+
+type playerServiceClient struct {
+	srv playerServer
+}
+
+func (p playerServiceClient) GetPlayerByID(ctx context.Context, in *statistico.PlayerRequest, _ ...grpc.CallOption) (*statistico.Player, error) {
+	return unary(ctx, func() (*statistico.Player, error) { return p.srv.GetPlayerByID(ctx, in) })
+}
+
+type playerStatsServiceClient struct {
+	srv playerStatsServer
+}
+
+func (p playerStatsServiceClient) GetPlayerStatsForFixture(ctx context.Context, in *statistico.FixtureRequest, _ ...grpc.CallOption) (*statistico.PlayerStatsResponse, error) {
+	return unary(ctx, func() (*statistico.PlayerStatsResponse, error) { return p.srv.GetPlayerStatsForFixture(ctx, in) })
+}
+
+func (p playerStatsServiceClient) GetLineUpForFixture(ctx context.Context, in *statistico.FixtureRequest, _ ...grpc.CallOption) (*statistico.LineupResponse, error) {
+	return unary(ctx, func() (*statistico.LineupResponse, error) { return p.srv.GetLineUpForFixture(ctx, in) })
+}
+
+func (p playerStatsServiceClient) GetTeamSeasonPlayerStats(ctx context.Context, in *statistico.TeamSeasonPlayStatsRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.PlayerStats], error) {
+	return serverStream(ctx, func(s grpc.ServerStreamingServer[statistico.PlayerStats]) error {
+		return p.srv.GetTeamSeasonPlayerStats(in, s)
+	})
+}
+
+type seasonServiceClient struct {
+	srv seasonServer
+}
+
+func (ss seasonServiceClient) GetSeasonsForCompetition(ctx context.Context, in *statistico.SeasonCompetitionRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Season], error) {
+	return serverStream(ctx, func(s grpc.ServerStreamingServer[statistico.Season]) error {
+		return ss.srv.GetSeasonsForCompetition(in, s)
+	})
+}
+
+func (ss seasonServiceClient) GetSeasonsForTeam(ctx context.Context, in *statistico.TeamSeasonsRequest, _ ...grpc.CallOption) (*statistico.TeamSeasonsResponse, error) {
+	return unary(ctx, func() (*statistico.TeamSeasonsResponse, error) { return ss.srv.GetSeasonsForTeam(ctx, in) })
+}
+
+type teamServiceClient struct {
+	srv teamServer
+}
+
+func (t teamServiceClient) GetTeamByID(ctx context.Context, in *statistico.TeamRequest, _ ...grpc.CallOption) (*statistico.Team, error) {
+	return unary(ctx, func() (*statistico.Team, error) { return t.srv.GetTeamByID(ctx, in) })
+}
+
+func (t teamServiceClient) GetTeamsByCompetitionId(ctx context.Context, in *statistico.CompetitionTeamsRequest, _ ...grpc.CallOption) (*statistico.TeamsResponse, error) {
+	return unary(ctx, func() (*statistico.TeamsResponse, error) { return t.srv.GetTeamsByCompetitionId(ctx, in) })
+}
+
+func (t teamServiceClient) GetTeamsBySeasonId(ctx context.Context, in *statistico.SeasonTeamsRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[statistico.Team], error) {
+	return serverStream(ctx, func(s grpc.ServerStreamingServer[statistico.Team]) error {
+		return t.srv.GetTeamsBySeasonId(in, s)
+	})
+}
+
+type teamStatsServiceClient struct {
+	srv teamStatsServer
+}
+
+func (t teamStatsServiceClient) GetTeamStatsForFixture(ctx context.Context, in *statistico.FixtureRequest, _ ...grpc.CallOption) (*statistico.TeamStatsResponse, error) {
+	return unary(ctx, func() (*statistico.TeamStatsResponse, error) { return t.srv.GetTeamStatsForFixture(ctx, in) })
+}
+
+var (
+	_ statistico.CompetitionServiceClient = competitionServiceClient{}
+	_ statistico.EventServiceClient       = eventServiceClient{}
+	_ statistico.FixtureServiceClient     = fixtureServiceClient{}
+	_ statistico.PlayerServiceClient      = playerServiceClient{}
+	_ statistico.PlayerStatsServiceClient = playerStatsServiceClient{}
+	_ statistico.SeasonServiceClient      = seasonServiceClient{}
+	_ statistico.TeamServiceClient        = teamServiceClient{}
+	_ statistico.TeamStatsServiceClient   = teamStatsServiceClient{}
+)
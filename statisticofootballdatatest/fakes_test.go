@@ -0,0 +1,220 @@
+package statisticofootballdatatest_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-football-data-go-grpc-client/statisticofootballdatatest"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"testing"
+)
+
+func TestFakes(t *testing.T) {
+	t.Run("team client returns teams and not found errors", func(t *testing.T) {
+		t.Helper()
+
+		client := statisticofootballdatatest.NewTeamClient(fakeDataset())
+
+		team, err := client.ByID(context.Background(), 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "West Ham United", team.GetName())
+
+		teams, err := client.BySeasonID(context.Background(), 16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []uint64{1, 18}, teamIDs(teams))
+
+		_, err = client.ByID(context.Background(), 404)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.IsType(t, statisticofootballdata.ErrorNotFound{}, err)
+	})
+
+	t.Run("fixture client filters, sorts and limits searches", func(t *testing.T) {
+		t.Helper()
+
+		client := statisticofootballdatatest.NewFixtureClient(fakeDataset())
+
+		fixtures, err := client.Search(context.Background(), &statistico.FixtureSearchRequest{
+			TeamId: &wrapperspb.UInt64Value{Value: 18},
+			Sort:   &wrapperspb.StringValue{Value: "date_desc"},
+			Limit:  &wrapperspb.UInt64Value{Value: 1},
+		})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []int64{193}, fixtureIDs(fixtures))
+
+		_, err = client.Search(context.Background(), &statistico.FixtureSearchRequest{
+			Sort: &wrapperspb.StringValue{Value: "kick_off"},
+		})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.IsType(t, statisticofootballdata.ErrorExternalServer{}, err)
+
+		_, err = client.ByID(context.Background(), 999)
+
+		assert.IsType(t, statisticofootballdata.ErrorNotFound{}, err)
+	})
+
+	t.Run("season and competition clients return related data", func(t *testing.T) {
+		t.Helper()
+
+		ds := fakeDataset()
+
+		seasons, err := statisticofootballdatatest.NewSeasonClient(ds).ByTeamID(context.Background(), 1, "name_desc")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "2020/2021", seasons[0].GetName())
+
+		competitions, err := statisticofootballdatatest.NewCompetitionClient(ds).ByCountryID(context.Background(), 462)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "Premier League", competitions[0].GetName())
+	})
+
+	t.Run("fixture data clients return data for known fixtures", func(t *testing.T) {
+		t.Helper()
+
+		ds := fakeDataset()
+
+		events, err := statisticofootballdatatest.NewEventClient(ds).FixtureEvents(context.Background(), 192)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, uint64(192), events.GetFixtureId())
+
+		_, err = statisticofootballdatatest.NewEventClient(ds).FixtureEvents(context.Background(), 999)
+
+		assert.IsType(t, statisticofootballdata.ErrorNotFound{}, err)
+
+		stats, err := statisticofootballdatatest.NewTeamStatClient(ds).Stats(context.Background(), &statistico.FixtureRequest{FixtureId: 192})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Nil(t, stats.GetHomeTeam())
+
+		_, err = statisticofootballdatatest.NewPlayerStatsClient(ds).FixtureStats(context.Background(), &statistico.FixtureRequest{FixtureId: 192})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		_, err = statisticofootballdatatest.NewPlayerClient(ds).ByID(context.Background(), 37)
+
+		assert.IsType(t, statisticofootballdata.ErrorNotFound{}, err)
+	})
+
+	t.Run("returns copies of the dataset", func(t *testing.T) {
+		t.Helper()
+
+		ds := fakeDataset()
+		client := statisticofootballdatatest.NewTeamClient(ds)
+
+		team, err := client.ByID(context.Background(), 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		team.Name = "Thames Ironworks"
+
+		assert.Equal(t, "West Ham United", ds.Teams[0].GetName())
+	})
+
+	t.Run("returns errors for done contexts", func(t *testing.T) {
+		t.Helper()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := statisticofootballdatatest.NewTeamClient(fakeDataset()).ByID(ctx, 1)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Contains(t, err.Error(), "Canceled")
+	})
+
+	t.Run("applies client options", func(t *testing.T) {
+		t.Helper()
+
+		var methods []string
+
+		mw := func(next statisticofootballdata.Invoker) statisticofootballdata.Invoker {
+			return func(ctx context.Context, call *statisticofootballdata.Call) (any, error) {
+				methods = append(methods, call.Method)
+				return next(ctx, call)
+			}
+		}
+
+		client := statisticofootballdatatest.NewTeamClient(fakeDataset(), statisticofootballdata.WithMiddleware(mw))
+
+		_, err := client.BySeasonID(context.Background(), 16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []string{statisticofootballdata.MethodTeamBySeasonID}, methods)
+	})
+}
+
+func fakeDataset() *statisticofootballdatatest.Dataset {
+	premierLeague := &statistico.Competition{Id: 8, Name: "Premier League", CountryId: 462}
+	previous := &statistico.Season{Id: 16036, Name: "2019/2020"}
+	current := &statistico.Season{Id: 17420, Name: "2020/2021", IsCurrent: &wrapperspb.BoolValue{Value: true}}
+	westHam := &statistico.Team{Id: 1, Name: "West Ham United"}
+	chelsea := &statistico.Team{Id: 18, Name: "Chelsea"}
+
+	return &statisticofootballdatatest.Dataset{
+		Competitions: []*statistico.Competition{premierLeague},
+		Seasons:      []*statistico.Season{previous, current},
+		Teams:        []*statistico.Team{westHam, chelsea},
+		Fixtures: []*statistico.Fixture{
+			{
+				Id:          192,
+				Competition: premierLeague,
+				Season:      previous,
+				HomeTeam:    westHam,
+				AwayTeam:    chelsea,
+				DateTime:    &statistico.Date{Utc: 1568473200},
+			},
+			{
+				Id:          193,
+				Competition: premierLeague,
+				Season:      current,
+				HomeTeam:    chelsea,
+				AwayTeam:    westHam,
+				DateTime:    &statistico.Date{Utc: 1600527600},
+			},
+		},
+	}
+}
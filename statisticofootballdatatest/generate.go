@@ -0,0 +1,720 @@
+package statisticofootballdatatest
+
+import (
+	"fmt"
+	statistico "github.com/statistico/statistico-proto/go"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"time"
+)
+
+// GenerateConfig configures the Dataset created by Generate. Fields left as zero use the defaults.
+type GenerateConfig struct {
+	// Seed seeds the random source, so the same config always generates the same Dataset.
+	Seed int64
+	// Competitions is the number of leagues generated, each with its own teams. Defaults to 1.
+	Competitions int
+	// Seasons is the number of seasons of each competition, the last of which is current. Defaults to 1.
+	Seasons int
+	// Teams is the number of teams in each competition, at least 2. Defaults to 20.
+	Teams int
+	// SquadSize is the number of players in each team, at least 11. Defaults to 18.
+	SquadSize int
+	// Start is the date the first season starts, with a round of fixtures played each Saturday from
+	// then. Defaults to 1 August 2020.
+	Start time.Time
+	// Now, if set, leaves fixtures kicking off at or after it unplayed, without events, stats or line ups.
+	Now time.Time
+}
+
+// CountryID is the country of the competitions and teams created by Generate.
+const CountryID = 462
+
+// formation is the position of each player starting a generated fixture, in formation order.
+var formation = []string{"G", "D", "D", "D", "D", "M", "M", "M", "M", "A", "A"}
+
+var (
+	leagueNames = []string{"Premier Division", "Championship", "League One", "League Two", "National League"}
+	towns       = []string{
+		"Ashford", "Barnsley", "Bramhall", "Carlisle", "Chesterfield", "Colchester", "Darlington", "Dorchester",
+		"Exeter", "Fleetwood", "Gateshead", "Gillingham", "Halifax", "Harrogate", "Hereford", "Ipswich",
+		"Kettering", "Kidderminster", "Lincoln", "Maidstone", "Mansfield", "Morecambe", "Northampton",
+		"Oldham", "Peterborough", "Plymouth", "Rochdale", "Salford", "Scunthorpe", "Shrewsbury", "Southport",
+		"Stevenage", "Stockport", "Swindon", "Torquay", "Tranmere", "Walsall", "Wigan", "Woking", "Yeovil",
+	}
+	suffixes   = []string{"United", "City", "Town", "Rovers", "Athletic", "Albion", "Wanderers", "County"}
+	firstNames = []string{
+		"Aaron", "Ben", "Callum", "Daniel", "Ethan", "Finn", "George", "Harry", "Isaac", "Jack", "Kieran",
+		"Liam", "Mason", "Nathan", "Oliver", "Patrick", "Reece", "Sam", "Tom", "Will",
+	}
+	lastNames = []string{
+		"Adams", "Baker", "Clarke", "Davies", "Evans", "Fletcher", "Graham", "Hughes", "Jones", "King",
+		"Lewis", "Morgan", "Noble", "Owen", "Parker", "Roberts", "Smith", "Taylor", "Walker", "Wright",
+	}
+)
+
+// Generate creates a Dataset of synthetic but consistent league data from a seed. Each competition has
+// its own teams, which play every other team in the competition home and away each season. Played
+// fixtures have scores, recorded by their goal events and team stats, alongside cards, player stats
+// and line ups drawn from each team's squad.
+//
+// The Dataset can be served by NewServer or NewService, used by the fake clients, or written as JSON to
+// be loaded by the fake server command.
+func Generate(cfg GenerateConfig) *Dataset {
+	g := &generator{
+		cfg:   cfg.withDefaults(),
+		rng:   rand.New(rand.NewSource(cfg.Seed)),
+		names: map[string]bool{},
+		ds: &Dataset{
+			Events:      map[uint64]*statistico.FixtureEventsResponse{},
+			PlayerStats: map[uint64]*statistico.PlayerStatsResponse{},
+			TeamStats:   map[uint64]*statistico.TeamStatsResponse{},
+			Lineups:     map[uint64]*statistico.LineupResponse{},
+		},
+	}
+
+	for c := 0; c < g.cfg.Competitions; c++ {
+		g.competition(c)
+	}
+
+	return g.ds
+}
+
+func (c GenerateConfig) withDefaults() GenerateConfig {
+	if c.Competitions <= 0 {
+		c.Competitions = 1
+	}
+
+	if c.Seasons <= 0 {
+		c.Seasons = 1
+	}
+
+	if c.Teams == 0 {
+		c.Teams = 20
+	}
+
+	if c.Teams < 2 {
+		c.Teams = 2
+	}
+
+	if c.SquadSize == 0 {
+		c.SquadSize = 18
+	}
+
+	if c.SquadSize < len(formation) {
+		c.SquadSize = len(formation)
+	}
+
+	if c.Start.IsZero() {
+		c.Start = time.Date(2020, time.August, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	return c
+}
+
+type generator struct {
+	cfg   GenerateConfig
+	rng   *rand.Rand
+	ds    *Dataset
+	names map[string]bool
+
+	seasonID, teamID, playerID, roundID, eventID uint64
+	fixtureID                                    int64
+}
+
+type squadPlayer struct {
+	*statistico.Player
+	position string
+}
+
+type team struct {
+	*statistico.Team
+	strength float64
+	squad    []squadPlayer
+}
+
+func (g *generator) competition(c int) {
+	name := fmt.Sprintf("Division %d", c+1)
+
+	if c < len(leagueNames) {
+		name = leagueNames[c]
+	}
+
+	comp := &statistico.Competition{Id: uint64(c + 1), Name: name, CountryId: CountryID, Type: "domestic"}
+
+	g.ds.Competitions = append(g.ds.Competitions, comp)
+
+	teams := make([]*team, g.cfg.Teams)
+
+	for i := range teams {
+		teams[i] = g.team()
+	}
+
+	for s := 0; s < g.cfg.Seasons; s++ {
+		start := g.cfg.Start.AddDate(s, 0, 0)
+
+		g.seasonID++
+
+		season := &statistico.Season{
+			Id:        g.seasonID,
+			Name:      fmt.Sprintf("%d/%d", start.Year(), start.Year()+1),
+			IsCurrent: wrapperspb.Bool(s == g.cfg.Seasons-1),
+		}
+
+		g.ds.Seasons = append(g.ds.Seasons, season)
+
+		g.season(comp, season, start, teams)
+	}
+}
+
+func (g *generator) team() *team {
+	g.teamID++
+
+	town := towns[g.rng.Intn(len(towns))]
+	name := town + " " + suffixes[g.rng.Intn(len(suffixes))]
+
+	for attempt := 0; g.names[name]; attempt++ {
+		town = towns[g.rng.Intn(len(towns))]
+		name = town + " " + suffixes[g.rng.Intn(len(suffixes))]
+
+		if attempt >= 10 {
+			name = fmt.Sprintf("%s %d", name, g.teamID)
+		}
+	}
+
+	g.names[name] = true
+
+	t := &team{
+		Team: &statistico.Team{
+			Id:        g.teamID,
+			Name:      name,
+			ShortCode: wrapperspb.String(strings.ToUpper(town[:3])),
+			CountryId: CountryID,
+			VenueId:   g.teamID,
+			Gender:    "male",
+			Founded:   wrapperspb.UInt64(uint64(1870 + g.rng.Intn(50))),
+		},
+		strength: 0.7 + g.rng.Float64()*0.6,
+	}
+
+	g.ds.Teams = append(g.ds.Teams, t.Team)
+
+	for i := 0; i < g.cfg.SquadSize; i++ {
+		position := formation[i%len(formation)]
+
+		// Squad players beyond the first eleven cover each position in turn, so every squad can field
+		// the formation with a full bench.
+		if i >= len(formation) {
+			position = []string{"G", "D", "M", "A"}[(i-len(formation))%4]
+		}
+
+		t.squad = append(t.squad, squadPlayer{Player: g.player(), position: position})
+	}
+
+	return t
+}
+
+func (g *generator) player() *statistico.Player {
+	g.playerID++
+
+	first := firstNames[g.rng.Intn(len(firstNames))]
+	last := lastNames[g.rng.Intn(len(lastNames))]
+	born := g.cfg.Start.AddDate(-18-g.rng.Intn(17), 0, -g.rng.Intn(365))
+
+	p := &statistico.Player{
+		Id:            g.playerID,
+		CountryId:     CountryID,
+		NationalityId: CountryID,
+		CommonName:    first[:1] + ". " + last,
+		FirstName:     first,
+		LastName:      last,
+		Name:          first + " " + last,
+		DisplayName:   first + " " + last,
+		Height:        int32(165 + g.rng.Intn(36)),
+		Weight:        int32(60 + g.rng.Intn(36)),
+		DateOfBirth:   born.Format(time.DateOnly),
+		Gender:        "male",
+	}
+
+	g.ds.Players = append(g.ds.Players, p)
+
+	return p
+}
+
+// season schedules a double round robin between teams, with a round played each Saturday from start.
+func (g *generator) season(comp *statistico.Competition, season *statistico.Season, start time.Time, teams []*team) {
+	teams = slices.Clone(teams)
+
+	g.rng.Shuffle(len(teams), func(i, j int) { teams[i], teams[j] = teams[j], teams[i] })
+
+	day := start
+
+	for day.Weekday() != time.Saturday {
+		day = day.AddDate(0, 0, 1)
+	}
+
+	for r, pairs := range roundRobin(len(teams)) {
+		date := day.AddDate(0, 0, 7*r)
+
+		g.roundID++
+
+		round := &statistico.Round{
+			Id:        g.roundID,
+			Name:      fmt.Sprint(r + 1),
+			SeasonId:  season.Id,
+			StartDate: date.Format(time.DateOnly),
+			EndDate:   date.Format(time.DateOnly),
+		}
+
+		kickoff := date.Add(15 * time.Hour)
+
+		for _, p := range pairs {
+			home, away := teams[p[0]], teams[p[1]]
+
+			g.fixtureID++
+
+			f := &statistico.Fixture{
+				Id:          g.fixtureID,
+				Competition: &statistico.Competition{Id: comp.Id, Name: comp.Name, CountryId: comp.CountryId},
+				Season:      &statistico.Season{Id: season.Id, Name: season.Name},
+				HomeTeam:    &statistico.Team{Id: home.Id, Name: home.Name},
+				AwayTeam:    &statistico.Team{Id: away.Id, Name: away.Name},
+				Round:       round,
+				Venue:       &statistico.Venue{Id: home.VenueId, Name: home.Name + " Stadium"},
+				DateTime:    &statistico.Date{Utc: kickoff.Unix(), Rfc: kickoff.Format(time.RFC3339)},
+			}
+
+			g.ds.Fixtures = append(g.ds.Fixtures, f)
+
+			if g.cfg.Now.IsZero() || kickoff.Before(g.cfg.Now) {
+				g.play(f, home, away)
+			}
+		}
+	}
+}
+
+// roundRobin returns the rounds of a double round robin between n teams, as pairs of home and away
+// team indexes, using the circle method. Each team plays every other team at home in one half of the
+// schedule and away in the other, and with an odd number of teams one team rests each round.
+func roundRobin(n int) [][][2]int {
+	idx := make([]int, n)
+
+	for i := range idx {
+		idx[i] = i
+	}
+
+	if n%2 == 1 {
+		idx = append(idx, -1)
+	}
+
+	m := len(idx)
+	first := make([][][2]int, 0, m-1)
+
+	for r := 0; r < m-1; r++ {
+		pairs := [][2]int{}
+
+		for i := 0; i < m/2; i++ {
+			home, away := idx[i], idx[m-1-i]
+
+			if home < 0 || away < 0 {
+				continue
+			}
+
+			if (r+i)%2 == 1 {
+				home, away = away, home
+			}
+
+			pairs = append(pairs, [2]int{home, away})
+		}
+
+		first = append(first, pairs)
+
+		// Keep the first team fixed and rotate the others one place.
+		idx = append(idx[:1], append([]int{idx[m-1]}, idx[1:m-1]...)...)
+	}
+
+	rounds := slices.Clone(first)
+
+	for _, pairs := range first {
+		second := make([][2]int, len(pairs))
+
+		for i, p := range pairs {
+			second[i] = [2]int{p[1], p[0]}
+		}
+
+		rounds = append(rounds, second)
+	}
+
+	return rounds
+}
+
+// side holds what happened to one team in a played fixture.
+type side struct {
+	team       *team
+	lineup     *statistico.Lineup
+	starters   []squadPlayer
+	goals      []*statistico.GoalEvent
+	halfTime   int32
+	cards      []*statistico.CardEvent
+	conceded   int32
+	shots      *statistico.TeamShots
+	possession int32
+}
+
+// play simulates a fixture, recording its events, team stats, player stats and line ups. Goals are
+// drawn from Poisson distributions weighted by the strength of each team.
+func (g *generator) play(f *statistico.Fixture, home, away *team) {
+	h, a := g.side(home), g.side(away)
+
+	g.score(f, h, a, g.poisson(1.5*home.strength/away.strength), g.poisson(1.1*away.strength/home.strength))
+
+	g.book(h)
+	g.book(a)
+
+	h.possession = int32(50 + 20*(home.strength-away.strength)/(home.strength+away.strength) + float64(g.rng.Intn(11)-5))
+	a.possession = 100 - h.possession
+
+	h.shots = g.shots(len(h.goals))
+	a.shots = g.shots(len(a.goals))
+
+	id := uint64(f.Id)
+
+	cards := append(slices.Clone(h.cards), a.cards...)
+
+	slices.SortStableFunc(cards, func(x, y *statistico.CardEvent) int { return int(x.Minute) - int(y.Minute) })
+
+	g.ds.Events[id] = &statistico.FixtureEventsResponse{
+		FixtureId: id,
+		Goals:     append(slices.Clone(h.goals), a.goals...),
+		Cards:     cards,
+	}
+
+	slices.SortStableFunc(g.ds.Events[id].Goals, func(x, y *statistico.GoalEvent) int { return int(x.Minute) - int(y.Minute) })
+
+	g.ds.Lineups[id] = &statistico.LineupResponse{HomeTeam: h.lineup, AwayTeam: a.lineup}
+
+	g.ds.TeamStats[id] = &statistico.TeamStatsResponse{
+		HomeTeam: g.teamStats(id, h, a),
+		AwayTeam: g.teamStats(id, a, h),
+	}
+
+	g.ds.PlayerStats[id] = &statistico.PlayerStatsResponse{
+		HomeTeam: g.playerStats(f, h, a),
+		AwayTeam: g.playerStats(f, a, h),
+	}
+}
+
+// side picks the players of a team starting a fixture, and the players on the bench.
+func (g *generator) side(t *team) *side {
+	squad := slices.Clone(t.squad)
+
+	g.rng.Shuffle(len(squad), func(i, j int) { squad[i], squad[j] = squad[j], squad[i] })
+
+	s := &side{team: t, lineup: &statistico.Lineup{Start: []*statistico.LineupPlayer{}, Bench: []*statistico.LineupPlayer{}}}
+
+	for i, position := range formation {
+		n := slices.IndexFunc(squad, func(p squadPlayer) bool { return p.position == position })
+		p := squad[n]
+
+		s.starters = append(s.starters, p)
+		squad = slices.Delete(squad, n, n+1)
+
+		s.lineup.Start = append(s.lineup.Start, &statistico.LineupPlayer{
+			PlayerId:          p.Id,
+			Position:          position,
+			FormationPosition: wrapperspb.UInt32(uint32(i + 1)),
+		})
+	}
+
+	for _, p := range squad {
+		s.lineup.Bench = append(s.lineup.Bench, &statistico.LineupPlayer{PlayerId: p.Id, Position: p.position, IsSubstitute: true})
+	}
+
+	return s
+}
+
+// score creates the goal events of a fixture, with the running score of each goal.
+func (g *generator) score(f *statistico.Fixture, h, a *side, homeGoals, awayGoals int) {
+	type goal struct {
+		side   *side
+		minute uint32
+	}
+
+	goals := []goal{}
+
+	for i := 0; i < homeGoals; i++ {
+		goals = append(goals, goal{side: h, minute: uint32(1 + g.rng.Intn(90))})
+	}
+
+	for i := 0; i < awayGoals; i++ {
+		goals = append(goals, goal{side: a, minute: uint32(1 + g.rng.Intn(90))})
+	}
+
+	slices.SortStableFunc(goals, func(x, y goal) int { return int(x.minute) - int(y.minute) })
+
+	var hs, as int
+
+	for _, gl := range goals {
+		if gl.side == h {
+			hs++
+		} else {
+			as++
+		}
+
+		if gl.minute <= 45 {
+			gl.side.halfTime++
+		}
+
+		g.eventID++
+
+		scorer := g.scorer(gl.side)
+
+		e := &statistico.GoalEvent{
+			Id:       g.eventID,
+			TeamId:   gl.side.team.Id,
+			PlayerId: scorer.Id,
+			Minute:   gl.minute,
+			Score:    fmt.Sprintf("%d-%d", hs, as),
+		}
+
+		if g.rng.Float64() < 0.7 {
+			if assist := g.scorer(gl.side); assist.Id != scorer.Id {
+				e.PlayerAssistId = wrapperspb.UInt64(assist.Id)
+			}
+		}
+
+		gl.side.goals = append(gl.side.goals, e)
+	}
+
+	h.conceded = int32(len(a.goals))
+	a.conceded = int32(len(h.goals))
+}
+
+// scorer picks a starting outfield player, favouring attackers then midfielders.
+func (g *generator) scorer(s *side) squadPlayer {
+	weights := map[string]int{"G": 0, "D": 1, "M": 2, "A": 4}
+	total := 0
+
+	for _, p := range s.starters {
+		total += weights[p.position]
+	}
+
+	n := g.rng.Intn(total)
+
+	for _, p := range s.starters {
+		if n -= weights[p.position]; n < 0 {
+			return p
+		}
+	}
+
+	return s.starters[len(s.starters)-1]
+}
+
+// book creates the card events of a team, each shown to a different starting player.
+func (g *generator) book(s *side) {
+	players := slices.Clone(s.starters)
+
+	g.rng.Shuffle(len(players), func(i, j int) { players[i], players[j] = players[j], players[i] })
+
+	yellow := min(g.poisson(1.6), len(players)-1)
+
+	for _, p := range players[:yellow] {
+		g.card(s, p, "yellowcard")
+	}
+
+	if g.rng.Float64() < 0.05 {
+		g.card(s, players[yellow], "redcard")
+	}
+
+	slices.SortStableFunc(s.cards, func(x, y *statistico.CardEvent) int { return int(x.Minute) - int(y.Minute) })
+}
+
+func (g *generator) card(s *side, p squadPlayer, card string) {
+	g.eventID++
+
+	s.cards = append(s.cards, &statistico.CardEvent{
+		Id:       g.eventID,
+		TeamId:   s.team.Id,
+		Type:     card,
+		PlayerId: p.Id,
+		Minute:   uint32(1 + g.rng.Intn(90)),
+	})
+}
+
+func (g *generator) shots(goals int) *statistico.TeamShots {
+	onGoal := goals + g.poisson(3)
+	offGoal := g.poisson(5)
+	blocked := g.poisson(3)
+	total := onGoal + offGoal + blocked
+	inside := onGoal + g.rng.Intn(offGoal+blocked+1)
+
+	return &statistico.TeamShots{
+		Total:      int32Value(total),
+		OnGoal:     int32Value(onGoal),
+		OffGoal:    int32Value(offGoal),
+		Blocked:    int32Value(blocked),
+		InsideBox:  int32Value(inside),
+		OutsideBox: int32Value(total - inside),
+	}
+}
+
+func (g *generator) teamStats(fixtureID uint64, s, opp *side) *statistico.TeamStats {
+	passes := int(s.possession)*9 + g.rng.Intn(80)
+	success := passes * (70 + g.rng.Intn(20)) / 100
+	attacks := 80 + g.rng.Intn(60)
+	crosses := g.poisson(15)
+	dribbles := g.poisson(12)
+	headers := g.poisson(20)
+	yellow, red := cardCounts(s.cards)
+
+	return &statistico.TeamStats{
+		TeamId:         s.team.Id,
+		FixtureId:      fixtureID,
+		Assists:        int32Value(assists(s.goals)),
+		BallPossession: wrapperspb.Int32(s.possession),
+		Corners:        int32Value(g.poisson(5)),
+		Fouls:          int32Value(g.poisson(11)),
+		FreeKicks:      int32Value(g.poisson(12)),
+		GoalKicks:      int32Value(g.poisson(7)),
+		Offsides:       int32Value(g.poisson(2)),
+		Saves:          wrapperspb.Int32(opp.shots.OnGoal.Value - s.conceded),
+		Substitutions:  wrapperspb.Int32(0),
+		Tackles:        int32Value(g.poisson(17)),
+		ThrowIns:       int32Value(g.poisson(22)),
+		TeamAttacks: &statistico.TeamAttacks{
+			Total:     int32Value(attacks),
+			Dangerous: int32Value(attacks/3 + g.rng.Intn(attacks/3)),
+			Counter:   int32Value(g.poisson(3)),
+		},
+		TeamCards: &statistico.TeamCards{Yellow: int32Value(yellow), Red: int32Value(red), YellowRed: wrapperspb.Int32(0)},
+		TeamCrosses: &statistico.TeamCrosses{
+			Total:    int32Value(crosses),
+			Accurate: int32Value(crosses / 3),
+		},
+		TeamDribbles: &statistico.TeamDribbles{
+			Total:   int32Value(dribbles),
+			Success: int32Value(dribbles / 2),
+		},
+		TeamGoals: &statistico.TeamGoals{
+			Scored:           int32Value(len(s.goals)),
+			ScoredHalfTime:   wrapperspb.Int32(s.halfTime),
+			Conceded:         wrapperspb.Int32(s.conceded),
+			ConcededHalfTime: wrapperspb.Int32(opp.halfTime),
+			Attempts:         s.shots.Total,
+		},
+		TeamHeaders: &statistico.TeamHeaders{
+			Total:   int32Value(headers),
+			Success: int32Value(headers / 2),
+		},
+		TeamPasses: &statistico.TeamPasses{
+			Total:      int32Value(passes),
+			Success:    int32Value(success),
+			Percentage: int32Value(success * 100 / passes),
+			Key:        int32Value(g.poisson(8)),
+			Long:       int32Value(g.poisson(50)),
+		},
+		TeamShots: s.shots,
+	}
+}
+
+func (g *generator) playerStats(f *statistico.Fixture, s, opp *side) []*statistico.PlayerStats {
+	stats := make([]*statistico.PlayerStats, 0, len(s.starters))
+
+	for _, p := range s.starters {
+		var goals, assisted, yellow, red int32
+
+		minutes := int32(90)
+
+		for _, e := range s.goals {
+			if e.PlayerId == p.Id {
+				goals++
+			}
+
+			if e.GetPlayerAssistId().GetValue() == p.Id {
+				assisted++
+			}
+		}
+
+		for _, c := range s.cards {
+			if c.PlayerId != p.Id {
+				continue
+			}
+
+			if c.Type == "redcard" {
+				red++
+				minutes = int32(c.Minute)
+			} else {
+				yellow++
+			}
+		}
+
+		ps := &statistico.PlayerStats{
+			PlayerId:      p.Id,
+			SeasonId:      f.Season.Id,
+			TeamId:        s.team.Id,
+			FixtureId:     uint64(f.Id),
+			PlayerName:    p.Name,
+			Goals:         &statistico.Goals{Scored: wrapperspb.Int32(goals), Conceded: wrapperspb.Int32(s.conceded)},
+			Assists:       wrapperspb.Int32(assisted),
+			YellowCards:   wrapperspb.Int32(yellow),
+			RedCard:       wrapperspb.Int32(red),
+			MinutesPlayed: wrapperspb.Int32(minutes),
+			Rating:        wrapperspb.Float(float32(math.Round((6+g.rng.Float64()*1.5+float64(goals)*0.8+float64(assisted)*0.4)*10) / 10)),
+		}
+
+		if p.position == "G" {
+			ps.GoalKeeper = &statistico.GoalKeeper{
+				SavesTotal:    wrapperspb.Int32(opp.shots.OnGoal.Value - s.conceded),
+				GoalsConceded: wrapperspb.Int32(s.conceded),
+			}
+		}
+
+		stats = append(stats, ps)
+	}
+
+	return stats
+}
+
+// poisson returns a number drawn from a Poisson distribution with mean lambda.
+func (g *generator) poisson(lambda float64) int {
+	limit, k, p := math.Exp(-lambda), 0, 1.0
+
+	for {
+		if p *= g.rng.Float64(); p <= limit {
+			return k
+		}
+
+		k++
+	}
+}
+
+func cardCounts(cards []*statistico.CardEvent) (yellow, red int) {
+	for _, c := range cards {
+		if c.Type == "redcard" {
+			red++
+		} else {
+			yellow++
+		}
+	}
+
+	return yellow, red
+}
+
+func assists(goals []*statistico.GoalEvent) int {
+	n := 0
+
+	for _, e := range goals {
+		if e.PlayerAssistId != nil {
+			n++
+		}
+	}
+
+	return n
+}
+
+func int32Value(n int) *wrapperspb.Int32Value {
+	return wrapperspb.Int32(int32(n))
+}
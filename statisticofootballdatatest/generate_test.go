@@ -0,0 +1,230 @@
+package statisticofootballdatatest_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/statistico/statistico-football-data-go-grpc-client/conformance"
+	"github.com/statistico/statistico-football-data-go-grpc-client/statisticofootballdatatest"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"testing"
+	"time"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Run("generates the same data from the same seed", func(t *testing.T) {
+		t.Helper()
+
+		cfg := statisticofootballdatatest.GenerateConfig{Seed: 7, Teams: 6, Seasons: 2}
+
+		a, err := json.Marshal(statisticofootballdatatest.Generate(cfg))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		b, err := json.Marshal(statisticofootballdatatest.Generate(cfg))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		cfg.Seed = 8
+
+		c, err := json.Marshal(statisticofootballdatatest.Generate(cfg))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, string(a), string(b))
+		assert.NotEqual(t, string(a), string(c))
+	})
+
+	t.Run("generates a league using the defaults", func(t *testing.T) {
+		t.Helper()
+
+		ds := statisticofootballdatatest.Generate(statisticofootballdatatest.GenerateConfig{})
+
+		assert.Equal(t, 1, len(ds.Competitions))
+		assert.Equal(t, "Premier Division", ds.Competitions[0].GetName())
+		assert.Equal(t, 1, len(ds.Seasons))
+		assert.Equal(t, "2020/2021", ds.Seasons[0].GetName())
+		assert.True(t, ds.Seasons[0].GetIsCurrent().GetValue())
+		assert.Equal(t, 20, len(ds.Teams))
+		assert.Equal(t, 360, len(ds.Players))
+		assert.Equal(t, 380, len(ds.Fixtures))
+		assert.Equal(t, 380, len(ds.Events))
+		assert.Equal(t, 380, len(ds.TeamStats))
+		assert.Equal(t, 380, len(ds.PlayerStats))
+		assert.Equal(t, 380, len(ds.Lineups))
+		assert.Equal(t, "2020-08-01T15:00:00Z", ds.Fixtures[0].GetDateTime().GetRfc())
+	})
+
+	t.Run("schedules a double round robin each season", func(t *testing.T) {
+		t.Helper()
+
+		for _, teams := range []int{2, 5, 6} {
+			ds := statisticofootballdatatest.Generate(statisticofootballdatatest.GenerateConfig{Teams: teams, Seasons: 2})
+
+			for _, season := range ds.Seasons {
+				pairs := map[[2]uint64]int{}
+				rounds := map[string]map[uint64]bool{}
+
+				for _, f := range ds.Fixtures {
+					if f.GetSeason().GetId() != season.GetId() {
+						continue
+					}
+
+					home, away := f.GetHomeTeam().GetId(), f.GetAwayTeam().GetId()
+
+					pairs[[2]uint64{home, away}]++
+
+					round := f.GetRound().GetName()
+
+					if rounds[round] == nil {
+						rounds[round] = map[uint64]bool{}
+					}
+
+					assert.False(t, rounds[round][home] || rounds[round][away], "team plays twice in round %s", round)
+
+					rounds[round][home] = true
+					rounds[round][away] = true
+				}
+
+				assert.Equal(t, teams*(teams-1), len(pairs), "%d teams", teams)
+
+				for pair, n := range pairs {
+					assert.Equal(t, 1, n, "%d v %d", pair[0], pair[1])
+					assert.NotEqual(t, pair[0], pair[1])
+				}
+
+				assert.Equal(t, 2*(teams-1+teams%2), len(rounds), "%d teams", teams)
+			}
+		}
+	})
+
+	t.Run("records consistent scores, events, stats and line ups", func(t *testing.T) {
+		t.Helper()
+
+		ds := statisticofootballdatatest.Generate(statisticofootballdatatest.GenerateConfig{Seed: 3, Teams: 8})
+
+		for _, f := range ds.Fixtures {
+			id := uint64(f.GetId())
+			home, away := f.GetHomeTeam().GetId(), f.GetAwayTeam().GetId()
+			stats := ds.TeamStats[id]
+			lineup := ds.Lineups[id]
+
+			assert.Equal(t, 11, len(lineup.GetHomeTeam().GetStart()))
+			assert.Equal(t, 7, len(lineup.GetHomeTeam().GetBench()))
+			assert.Equal(t, 11, len(lineup.GetAwayTeam().GetStart()))
+
+			starters := map[uint64]uint64{}
+
+			for _, p := range lineup.GetHomeTeam().GetStart() {
+				starters[p.GetPlayerId()] = home
+			}
+
+			for _, p := range lineup.GetAwayTeam().GetStart() {
+				starters[p.GetPlayerId()] = away
+			}
+
+			goals := map[uint64]int32{home: 0, away: 0}
+			score := "0-0"
+
+			for _, g := range ds.Events[id].GetGoals() {
+				goals[g.GetTeamId()]++
+				score = g.GetScore()
+
+				assert.Equal(t, g.GetTeamId(), starters[g.GetPlayerId()], "scorer of fixture %d", id)
+				assert.Equal(t, fmt.Sprintf("%d-%d", goals[home], goals[away]), g.GetScore())
+			}
+
+			for _, c := range ds.Events[id].GetCards() {
+				assert.Equal(t, c.GetTeamId(), starters[c.GetPlayerId()], "card of fixture %d", id)
+			}
+
+			assert.Equal(t, fmt.Sprintf("%d-%d", goals[home], goals[away]), score)
+			assert.Equal(t, home, stats.GetHomeTeam().GetTeamId())
+			assert.Equal(t, goals[home], stats.GetHomeTeam().GetTeamGoals().GetScored().GetValue())
+			assert.Equal(t, goals[away], stats.GetHomeTeam().GetTeamGoals().GetConceded().GetValue())
+			assert.Equal(t, goals[away], stats.GetAwayTeam().GetTeamGoals().GetScored().GetValue())
+			assert.Equal(t, int32(100), stats.GetHomeTeam().GetBallPossession().GetValue()+stats.GetAwayTeam().GetBallPossession().GetValue())
+			assert.GreaterOrEqual(t, stats.GetHomeTeam().GetTeamShots().GetOnGoal().GetValue(), goals[home])
+			assert.GreaterOrEqual(t, stats.GetHomeTeam().GetSaves().GetValue(), int32(0))
+
+			scored := map[uint64]int32{}
+
+			for _, p := range append(ds.PlayerStats[id].GetHomeTeam(), ds.PlayerStats[id].GetAwayTeam()...) {
+				assert.Equal(t, p.GetTeamId(), starters[p.GetPlayerId()], "player stats of fixture %d", id)
+				assert.Equal(t, f.GetSeason().GetId(), p.GetSeasonId())
+
+				scored[p.GetTeamId()] += p.GetGoals().GetScored().GetValue()
+			}
+
+			assert.Equal(t, goals, scored, "fixture %d", id)
+		}
+	})
+
+	t.Run("leaves fixtures from now unplayed", func(t *testing.T) {
+		t.Helper()
+
+		ds := statisticofootballdatatest.Generate(statisticofootballdatatest.GenerateConfig{
+			Teams: 4,
+			Now:   time.Date(2020, time.August, 20, 0, 0, 0, 0, time.UTC),
+		})
+
+		assert.Equal(t, 12, len(ds.Fixtures))
+		assert.Equal(t, 6, len(ds.Events))
+		assert.Equal(t, 6, len(ds.TeamStats))
+
+		for _, f := range ds.Fixtures {
+			_, played := ds.Events[uint64(f.GetId())]
+
+			assert.Equal(t, f.GetDateTime().GetRfc() < "2020-08-20", played)
+		}
+	})
+
+	t.Run("feeds the fake clients and server", func(t *testing.T) {
+		t.Helper()
+
+		ds := statisticofootballdatatest.Generate(statisticofootballdatatest.GenerateConfig{Seed: 11, Competitions: 2, Seasons: 2, Teams: 6})
+
+		fixtures, err := statisticofootballdatatest.NewFixtureClient(ds).Search(context.Background(), &statistico.FixtureSearchRequest{
+			SeasonIds: []uint64{2},
+			TeamId:    &wrapperspb.UInt64Value{Value: 1},
+		})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 10, len(fixtures))
+
+		teams, err := statisticofootballdatatest.NewTeamClient(ds).BySeasonID(context.Background(), 3)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []uint64{7, 8, 9, 10, 11, 12}, teamIDs(teams))
+
+		srv := statisticofootballdatatest.NewServer(ds)
+		t.Cleanup(srv.Close)
+
+		report := conformance.Run(context.Background(), srv.Conn(), conformance.Config{
+			CountryID:     statisticofootballdatatest.CountryID,
+			CompetitionID: 1,
+			SeasonID:      1,
+			TeamID:        1,
+			FixtureID:     1,
+			PlayerID:      1,
+		})
+
+		for _, res := range report {
+			assert.Equal(t, conformance.Pass, res.Status, "%s: %s", res.Check, res.Message)
+		}
+	})
+}
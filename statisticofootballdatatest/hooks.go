@@ -0,0 +1,212 @@
+package statisticofootballdatatest
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AllMethods applies latency or an error set using Hooks to every data service method without its own.
+// Other methods, such as health checks and reflection, are unaffected.
+const AllMethods = ""
+
+// DataServiceMethod reports whether method, a full gRPC method name such as
+// statistico.TeamService_GetTeamByID_FullMethodName, belongs to one of statisticofootballdata.Services.
+func DataServiceMethod(method string) bool {
+	service, _, _ := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+
+	return slices.Contains(statisticofootballdata.Services, service)
+}
+
+// HookFunc is called before each call is handled, with the full gRPC method name such as
+// statistico.TeamService_GetTeamByID_FullMethodName and the request. Returning an error fails the call
+// with it, so return status errors to control the code seen by clients.
+type HookFunc func(ctx context.Context, method string, req any) error
+
+// Hooks injects latency and errors into calls handled by a gRPC server, keyed by full gRPC method name.
+// Hooks can be changed while calls are being handled.
+type Hooks struct {
+	mu      sync.RWMutex
+	latency map[string]time.Duration
+	errs    map[string]error
+	fns     []HookFunc
+}
+
+// Option configures the Hooks of a Server.
+type Option func(h *Hooks)
+
+// WithLatency delays calls to method, or every method using AllMethods, by d.
+func WithLatency(method string, d time.Duration) Option {
+	return func(h *Hooks) {
+		h.SetLatency(method, d)
+	}
+}
+
+// WithError fails calls to method, or every method using AllMethods, with err.
+func WithError(method string, err error) Option {
+	return func(h *Hooks) {
+		h.SetError(method, err)
+	}
+}
+
+// WithHook calls fn before each call is handled.
+func WithHook(fn HookFunc) Option {
+	return func(h *Hooks) {
+		h.AddHook(fn)
+	}
+}
+
+// NewHooks creates Hooks configured by opts.
+func NewHooks(opts ...Option) *Hooks {
+	h := &Hooks{}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// SetLatency delays calls to method, or every method using AllMethods, by d. A zero d removes the delay.
+func (h *Hooks) SetLatency(method string, d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.latency == nil {
+		h.latency = map[string]time.Duration{}
+	}
+
+	if d == 0 {
+		delete(h.latency, method)
+		return
+	}
+
+	h.latency[method] = d
+}
+
+// SetError fails calls to method, or every method using AllMethods, with err. A nil err removes the
+// error.
+func (h *Hooks) SetError(method string, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.errs == nil {
+		h.errs = map[string]error{}
+	}
+
+	if err == nil {
+		delete(h.errs, method)
+		return
+	}
+
+	h.errs[method] = err
+}
+
+// AddHook calls fn before each call is handled, after any latency.
+func (h *Hooks) AddHook(fn HookFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.fns = append(h.fns, fn)
+}
+
+// Reset removes all latency, errors and hooks.
+func (h *Hooks) Reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.latency = nil
+	h.errs = nil
+	h.fns = nil
+}
+
+// ServerOptions returns the options installing the Hooks into a grpc.Server.
+func (h *Hooks) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(h.unary),
+		grpc.ChainStreamInterceptor(h.stream),
+	}
+}
+
+func (h *Hooks) before(ctx context.Context, method string, req any) error {
+	all := DataServiceMethod(method)
+
+	h.mu.RLock()
+	latency, ok := h.latency[method]
+
+	if !ok && all {
+		latency = h.latency[AllMethods]
+	}
+
+	err, ok := h.errs[method]
+
+	if !ok && all {
+		err = h.errs[AllMethods]
+	}
+
+	fns := h.fns
+	h.mu.RUnlock()
+
+	if latency > 0 {
+		t := time.NewTimer(latency)
+		defer t.Stop()
+
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, fn := range fns {
+		if err := fn(ctx, method, req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *Hooks) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := h.before(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (h *Hooks) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &hookedStream{ServerStream: ss, hooks: h, method: info.FullMethod})
+}
+
+// hookedStream runs the hooks once the request of a server streaming call has been received, before
+// the handler sends any responses.
+type hookedStream struct {
+	grpc.ServerStream
+	hooks    *Hooks
+	method   string
+	received bool
+}
+
+func (s *hookedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if s.received {
+		return nil
+	}
+
+	s.received = true
+
+	return s.hooks.before(s.Context(), s.method, m)
+}
@@ -0,0 +1,145 @@
+package statisticofootballdatatest
+
+import (
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// DataExtensions are the extensions of the files read by LoadDatasetDir.
+var DataExtensions = []string{".json", ".yaml", ".yml"}
+
+// LoadDataset reads a Dataset from a JSON file, or from a YAML file with the same structure if its
+// extension is .yaml or .yml.
+func LoadDataset(path string) (*Dataset, error) {
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if ext := filepath.Ext(path); ext == ".yaml" || ext == ".yml" {
+		if b, err = yamlToJSON(b); err != nil {
+			return nil, fmt.Errorf("loading dataset %s: %w", path, err)
+		}
+	}
+
+	ds := &Dataset{}
+
+	if err := json.Unmarshal(b, ds); err != nil {
+		return nil, fmt.Errorf("loading dataset %s: %w", path, err)
+	}
+
+	return ds, nil
+}
+
+// LoadDatasetDir reads the JSON and YAML files in dir in name order, merging them into a single Dataset.
+// Later files add to the lists of earlier files and replace their entries in maps.
+func LoadDatasetDir(dir string) (*Dataset, error) {
+	files, err := DataFiles(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ds := &Dataset{}
+
+	for _, f := range files {
+		d, err := LoadDataset(f)
+
+		if err != nil {
+			return nil, err
+		}
+
+		ds.merge(d)
+	}
+
+	return ds, nil
+}
+
+// DataFiles returns the paths of the files in dir read by LoadDatasetDir, in name order.
+func DataFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+
+	for _, e := range entries {
+		if !e.IsDir() && slices.Contains(DataExtensions, strings.ToLower(filepath.Ext(e.Name()))) {
+			files = append(files, filepath.Join(dir, e.Name()))
+		}
+	}
+
+	return files, nil
+}
+
+func (d *Dataset) merge(o *Dataset) {
+	d.Competitions = append(d.Competitions, o.Competitions...)
+	d.Seasons = append(d.Seasons, o.Seasons...)
+	d.Teams = append(d.Teams, o.Teams...)
+	d.Fixtures = append(d.Fixtures, o.Fixtures...)
+	d.Players = append(d.Players, o.Players...)
+	d.Events = mergeMap(d.Events, o.Events)
+	d.PlayerStats = mergeMap(d.PlayerStats, o.PlayerStats)
+	d.TeamStats = mergeMap(d.TeamStats, o.TeamStats)
+	d.Lineups = mergeMap(d.Lineups, o.Lineups)
+	d.SeasonTeams = mergeMap(d.SeasonTeams, o.SeasonTeams)
+	d.CompetitionSeasons = mergeMap(d.CompetitionSeasons, o.CompetitionSeasons)
+}
+
+func mergeMap[V any](dst, src map[uint64]V) map[uint64]V {
+	if dst == nil {
+		dst = map[uint64]V{}
+	}
+
+	maps.Copy(dst, src)
+
+	return dst
+}
+
+func yamlToJSON(b []byte) ([]byte, error) {
+	var v any
+
+	if err := yaml.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(jsonValue(v))
+}
+
+// jsonValue converts the maps decoded from YAML, which can have keys of any type such as the fixture IDs
+// keying events, into maps with string keys.
+func jsonValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			v[k] = jsonValue(e)
+		}
+
+		return v
+	case map[any]any:
+		m := make(map[string]any, len(v))
+
+		for k, e := range v {
+			m[fmt.Sprint(k)] = jsonValue(e)
+		}
+
+		return m
+	case []any:
+		for i, e := range v {
+			v[i] = jsonValue(e)
+		}
+
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,318 @@
+package statisticofootballdatatest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recording is the golden file format of a call, holding its request, every response received and the
+// status it ended with.
+type recording struct {
+	Method    string            `json:"method"`
+	Request   json.RawMessage   `json:"request"`
+	Responses []json.RawMessage `json:"responses"`
+	Status    *recordedStatus   `json:"status,omitempty"`
+}
+
+type recordedStatus struct {
+	Code    codes.Code `json:"code"`
+	Message string     `json:"message"`
+}
+
+// recordingPath returns the path of the golden file of a call to method with req, named after the method
+// and a hash of the request, such as statistico.TeamService.GetTeamByID-1d5b0c8ffb8b8d3e.json.
+func recordingPath(dir, method string, req any) (string, error) {
+	m, ok := req.(proto.Message)
+
+	if !ok {
+		return "", fmt.Errorf("request of %s is a %T, not a proto message", method, req)
+	}
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(append([]byte(method+"\n"), b...))
+
+	name := strings.ReplaceAll(strings.TrimPrefix(method, "/"), "/", ".")
+
+	return filepath.Join(dir, name+"-"+hex.EncodeToString(sum[:8])+".json"), nil
+}
+
+// Recorder records the calls made over a connection to golden files in a directory, for replaying
+// using a ReplayConn. Install it using its DialOptions.
+type Recorder struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewRecorder creates a Recorder writing golden files to dir, which is created if it doesn't exist.
+// Recording a call again overwrites its golden file.
+func NewRecorder(dir string) *Recorder {
+	return &Recorder{dir: dir}
+}
+
+// DialOptions returns the options installing the Recorder into a connection, for use with
+// statisticofootballdata.WithGRPCDialOptions.
+func (r *Recorder) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(r.UnaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(r.StreamClientInterceptor),
+	}
+}
+
+// UnaryClientInterceptor records unary calls.
+func (r *Recorder) UnaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+
+	var responses []any
+
+	if err == nil {
+		responses = []any{reply}
+	}
+
+	if werr := r.write(method, req, responses, err); werr != nil {
+		return werr
+	}
+
+	return err
+}
+
+// StreamClientInterceptor records server streaming calls once the stream has ended.
+func (r *Recorder) StreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &recordingStream{ClientStream: stream, recorder: r, method: method}, nil
+}
+
+type recordingStream struct {
+	grpc.ClientStream
+	recorder  *Recorder
+	method    string
+	req       any
+	responses []any
+}
+
+func (s *recordingStream) SendMsg(m any) error {
+	s.req = m
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *recordingStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+
+	if err == nil {
+		s.responses = append(s.responses, proto.Clone(m.(proto.Message)))
+		return nil
+	}
+
+	var end error
+
+	if err != io.EOF {
+		end = err
+	}
+
+	if werr := s.recorder.write(s.method, s.req, s.responses, end); werr != nil {
+		return werr
+	}
+
+	return err
+}
+
+func (r *Recorder) write(method string, req any, responses []any, callErr error) error {
+	path, err := recordingPath(r.dir, method, req)
+
+	if err != nil {
+		return err
+	}
+
+	rec := recording{Method: method, Responses: []json.RawMessage{}}
+
+	if rec.Request, err = protojson.Marshal(req.(proto.Message)); err != nil {
+		return err
+	}
+
+	for _, res := range responses {
+		b, err := protojson.Marshal(res.(proto.Message))
+
+		if err != nil {
+			return err
+		}
+
+		rec.Responses = append(rec.Responses, b)
+	}
+
+	if callErr != nil {
+		s := status.Convert(callErr)
+		rec.Status = &recordedStatus{Code: s.Code(), Message: s.Message()}
+	}
+
+	b, err := json.MarshalIndent(rec, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := os.MkdirAll(r.dir, 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(b, '\n'), 0o644)
+}
+
+// ReplayConn is a grpc.ClientConnInterface serving calls from the golden files written by a Recorder,
+// without a server. Calls without a golden file fail the test, reporting the request and the file
+// expected, and return a FailedPrecondition error.
+type ReplayConn struct {
+	t   testing.TB
+	dir string
+}
+
+// NewReplayConn creates a ReplayConn serving the golden files in dir for the test t.
+func NewReplayConn(t testing.TB, dir string) *ReplayConn {
+	return &ReplayConn{t: t, dir: dir}
+}
+
+func (c *ReplayConn) Invoke(ctx context.Context, method string, args any, reply any, _ ...grpc.CallOption) error {
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+
+	rec, err := c.load(method, args)
+
+	if err != nil {
+		return err
+	}
+
+	if len(rec.Responses) > 0 {
+		if err := protojson.Unmarshal(rec.Responses[0], reply.(proto.Message)); err != nil {
+			return err
+		}
+	}
+
+	return rec.err()
+}
+
+func (c *ReplayConn) NewStream(ctx context.Context, _ *grpc.StreamDesc, method string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	return &replayStream{ctx: ctx, conn: c, method: method}, nil
+}
+
+func (c *ReplayConn) load(method string, req any) (*recording, error) {
+	path, err := recordingPath(c.dir, method, req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := os.ReadFile(path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		body, _ := protojson.Marshal(req.(proto.Message))
+
+		c.t.Errorf("no recording of %s with request %s: expected %s", method, body, path)
+
+		return nil, status.Errorf(codes.FailedPrecondition, "no recording of %s with request %s", method, body)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	rec := &recording{}
+
+	if err := json.Unmarshal(b, rec); err != nil {
+		return nil, fmt.Errorf("reading recording %s: %w", path, err)
+	}
+
+	return rec, nil
+}
+
+func (r *recording) err() error {
+	if r.Status == nil {
+		return nil
+	}
+
+	return status.Error(r.Status.Code, r.Status.Message)
+}
+
+type replayStream struct {
+	ctx    context.Context
+	conn   *ReplayConn
+	method string
+	rec    *recording
+	next   int
+}
+
+func (s *replayStream) SendMsg(m any) error {
+	rec, err := s.conn.load(s.method, m)
+
+	if err != nil {
+		return err
+	}
+
+	s.rec = rec
+
+	return nil
+}
+
+func (s *replayStream) RecvMsg(m any) error {
+	if err := s.ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+
+	if s.next < len(s.rec.Responses) {
+		s.next++
+		return protojson.Unmarshal(s.rec.Responses[s.next-1], m.(proto.Message))
+	}
+
+	if err := s.rec.err(); err != nil {
+		return err
+	}
+
+	return io.EOF
+}
+
+func (s *replayStream) Header() (metadata.MD, error) {
+	return metadata.MD{}, nil
+}
+
+func (s *replayStream) Trailer() metadata.MD {
+	return metadata.MD{}
+}
+
+func (s *replayStream) CloseSend() error {
+	return nil
+}
+
+func (s *replayStream) Context() context.Context {
+	return s.ctx
+}
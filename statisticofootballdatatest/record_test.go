@@ -0,0 +1,153 @@
+package statisticofootballdatatest_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-football-data-go-grpc-client/statisticofootballdatatest"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRecorder(t *testing.T) {
+	t.Run("records calls that are replayed without a server", func(t *testing.T) {
+		t.Helper()
+
+		dir := t.TempDir()
+
+		record(t, dir, func(client *statisticofootballdata.Client) {
+			client.Teams.ByID(context.Background(), 1)
+			client.Teams.ByID(context.Background(), 404)
+			client.Teams.BySeasonID(context.Background(), 16036)
+			client.Fixtures.Search(context.Background(), &statistico.FixtureSearchRequest{
+				Sort: &wrapperspb.StringValue{Value: "kick_off"},
+			})
+		})
+
+		client := statisticofootballdata.NewClient(statisticofootballdatatest.NewReplayConn(t, dir))
+		defer client.Close()
+
+		team, err := client.Teams.ByID(context.Background(), 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "West Ham United", team.GetName())
+
+		_, err = client.Teams.ByID(context.Background(), 404)
+
+		assert.IsType(t, statisticofootballdata.ErrorNotFound{}, err)
+
+		teams, err := client.Teams.BySeasonID(context.Background(), 16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []uint64{1, 18, 19}, teamIDs(teams))
+
+		_, err = client.Fixtures.Search(context.Background(), &statistico.FixtureSearchRequest{
+			Sort: &wrapperspb.StringValue{Value: "kick_off"},
+		})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Contains(t, err.Error(), `sort "kick_off" is not supported`)
+	})
+
+	t.Run("writes golden files named after the method", func(t *testing.T) {
+		t.Helper()
+
+		dir := t.TempDir()
+
+		record(t, dir, func(client *statisticofootballdata.Client) {
+			client.Teams.ByID(context.Background(), 1)
+		})
+
+		files, err := filepath.Glob(filepath.Join(dir, "statistico.TeamService.GetTeamByID-*.json"))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(files))
+
+		b, err := os.ReadFile(files[0])
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Contains(t, string(b), `"method": "/statistico.TeamService/GetTeamByID"`)
+		assert.Contains(t, string(b), `"name": "West Ham United"`)
+	})
+}
+
+func TestReplayConn(t *testing.T) {
+	t.Run("fails the test for unrecorded requests", func(t *testing.T) {
+		t.Helper()
+
+		dir := t.TempDir()
+
+		record(t, dir, func(client *statisticofootballdata.Client) {
+			client.Teams.ByID(context.Background(), 1)
+		})
+
+		rt := &reportingT{}
+
+		client := statisticofootballdata.NewClient(statisticofootballdatatest.NewReplayConn(rt, dir))
+		defer client.Close()
+
+		_, err := client.Teams.ByID(context.Background(), 18)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		_, err = client.Seasons.ByCompetitionID(context.Background(), 8, "name_asc")
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, 2, len(rt.errors))
+		assert.Contains(t, rt.errors[0], `no recording of /statistico.TeamService/GetTeamByID with request {"teamId":"18"}`)
+		assert.Contains(t, rt.errors[1], "/statistico.SeasonService/GetSeasonsForCompetition")
+	})
+}
+
+func record(t *testing.T, dir string, calls func(client *statisticofootballdata.Client)) {
+	srv := newServer(t)
+
+	rec := statisticofootballdatatest.NewRecorder(dir)
+
+	client, err := statisticofootballdata.Dial(
+		statisticofootballdatatest.Target,
+		statisticofootballdata.WithGRPCDialOptions(append(srv.DialOptions(), rec.DialOptions()...)...),
+	)
+
+	if err != nil {
+		t.Fatalf("Expected nil, got %s", err.Error())
+	}
+
+	defer client.Close()
+
+	calls(client)
+}
+
+// reportingT records the errors reported to it.
+type reportingT struct {
+	testing.TB
+	errors []string
+}
+
+func (r *reportingT) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,88 @@
+package statisticofootballdatatest
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+)
+
+const bufferSize = 1024 * 1024
+
+// Target is the target of connections to a Server created using its DialOptions.
+const Target = "passthrough:///bufnet"
+
+// Server is an in-process data service serving a Dataset over an in-memory connection, so tests
+// exercise the real clients, including their error mapping, middleware and streaming, without a
+// network. Latency and errors can be injected using its Hooks.
+type Server struct {
+	*Service
+	*Hooks
+
+	srv  *grpc.Server
+	lis  *bufconn.Listener
+	conn *grpc.ClientConn
+}
+
+// NewServer starts a Server serving ds. Callers should call Close when finished.
+func NewServer(ds *Dataset, opts ...Option) *Server {
+	s := &Server{Service: NewService(ds), Hooks: NewHooks(opts...)}
+
+	s.lis = bufconn.Listen(bufferSize)
+
+	s.srv = grpc.NewServer(s.Hooks.ServerOptions()...)
+
+	s.Service.Register(s.srv)
+
+	hs := health.NewServer()
+
+	for _, service := range statisticofootballdata.Services {
+		hs.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+	}
+
+	healthpb.RegisterHealthServer(s.srv, hs)
+
+	go s.srv.Serve(s.lis)
+
+	conn, err := grpc.NewClient(Target, s.DialOptions()...)
+
+	if err != nil {
+		// The target is always valid
+		panic(err)
+	}
+
+	s.conn = conn
+
+	return s
+}
+
+// Conn returns a connection to the Server.
+func (s *Server) Conn() *grpc.ClientConn {
+	return s.conn
+}
+
+// DialOptions returns the options connecting to the Server when creating a connection to Target, for
+// use with statisticofootballdata.WithGRPCDialOptions.
+func (s *Server) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return s.lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+}
+
+// Client returns a Client making calls to the Server, with opts applied to every service client.
+func (s *Server) Client(opts ...statisticofootballdata.Option) *statisticofootballdata.Client {
+	return statisticofootballdata.NewClient(s.conn, opts...)
+}
+
+// Close closes the connection to the Server and stops it.
+func (s *Server) Close() {
+	s.conn.Close()
+	s.srv.Stop()
+}
@@ -0,0 +1,370 @@
+package statisticofootballdatatest_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-football-data-go-grpc-client/statisticofootballdatatest"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"testing"
+	"time"
+)
+
+func TestServer(t *testing.T) {
+	t.Run("serves the dataset to the real clients", func(t *testing.T) {
+		t.Helper()
+
+		srv := newServer(t)
+		client := srv.Client()
+		defer client.Close()
+
+		ctx := context.Background()
+
+		team, err := client.Teams.ByID(ctx, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "West Ham United", team.GetName())
+
+		teams, err := client.Teams.BySeasonID(ctx, 16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []uint64{1, 18, 19}, teamIDs(teams))
+
+		competitions, err := client.Competitions.ByCountryID(ctx, 462)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(competitions))
+
+		seasons, err := client.Seasons.ByCompetitionID(ctx, 24, "name_asc")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "2020/2021", seasons[0].GetName())
+
+		seasons, err = client.Seasons.ByTeamID(ctx, 1, "name_desc")
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []string{"2020/2021", "2019/2020"}, []string{seasons[0].GetName(), seasons[1].GetName()})
+
+		events, err := client.Events.FixtureEvents(ctx, 192)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, uint64(37), events.GetGoals()[0].GetPlayerId())
+
+		stats, err := client.TeamStats.Stats(ctx, &statistico.FixtureRequest{FixtureId: 192})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, int32(7), stats.GetHomeTeam().GetCorners().GetValue())
+
+		player, err := client.Players.ByID(ctx, 37)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "Mark Noble", player.GetName())
+
+		health, err := client.Health(ctx)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.True(t, health.Serving())
+	})
+
+	t.Run("returns not found errors for unknown IDs", func(t *testing.T) {
+		t.Helper()
+
+		srv := newServer(t)
+		client := srv.Client()
+		defer client.Close()
+
+		_, err := client.Fixtures.ByID(context.Background(), 999)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.IsType(t, statisticofootballdata.ErrorNotFound{}, err)
+		assert.Equal(t, uint64(999), err.(statisticofootballdata.ErrorNotFound).ID)
+	})
+
+	t.Run("searches fixtures by season, team and date", func(t *testing.T) {
+		t.Helper()
+
+		srv := newServer(t)
+		client := srv.Client()
+		defer client.Close()
+
+		fixtures, err := client.Fixtures.Search(context.Background(), &statistico.FixtureSearchRequest{
+			SeasonIds:  []uint64{16036, 17420},
+			TeamId:     &wrapperspb.UInt64Value{Value: 1},
+			DateAfter:  &wrapperspb.StringValue{Value: "2019-09-15T00:00:00Z"},
+			DateBefore: &wrapperspb.StringValue{Value: "2021-01-01T00:00:00Z"},
+			Sort:       &wrapperspb.StringValue{Value: "date_desc"},
+		})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []int64{200, 193}, fixtureIDs(fixtures))
+
+		fixtures, err = client.Fixtures.Search(context.Background(), &statistico.FixtureSearchRequest{
+			SeasonIds: []uint64{16036},
+			Limit:     &wrapperspb.UInt64Value{Value: 2},
+		})
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []int64{192, 193}, fixtureIDs(fixtures))
+	})
+
+	t.Run("returns the error status of invalid searches", func(t *testing.T) {
+		t.Helper()
+
+		srv := newServer(t)
+		client := srv.Client()
+		defer client.Close()
+
+		_, err := client.Fixtures.Search(context.Background(), &statistico.FixtureSearchRequest{
+			DateBefore: &wrapperspb.StringValue{Value: "14/09/2019"},
+		})
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		// Errors from server streaming calls are received from the stream, as from the data service
+		assert.IsType(t, statisticofootballdata.ErrorExternalServer{}, err)
+		assert.Contains(t, err.Error(), "date_before must be an RFC3339 formatted date")
+	})
+
+	t.Run("serves a replaced dataset", func(t *testing.T) {
+		t.Helper()
+
+		srv := newServer(t)
+		client := srv.Client()
+		defer client.Close()
+
+		srv.SetDataset(&statisticofootballdatatest.Dataset{Teams: []*statistico.Team{{Id: 1, Name: "Thames Ironworks"}}})
+
+		team, err := client.Teams.ByID(context.Background(), 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "Thames Ironworks", team.GetName())
+	})
+}
+
+func TestHooks(t *testing.T) {
+	t.Run("fails calls to a method with an error", func(t *testing.T) {
+		t.Helper()
+
+		srv := newServer(t, statisticofootballdatatest.WithError(
+			statistico.TeamService_GetTeamByID_FullMethodName,
+			status.Error(codes.Internal, "database unavailable"),
+		))
+		client := srv.Client()
+		defer client.Close()
+
+		_, err := client.Teams.ByID(context.Background(), 1)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.IsType(t, statisticofootballdata.ErrorBadGateway{}, err)
+
+		_, err = client.Fixtures.ByID(context.Background(), 192)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+	})
+
+	t.Run("fails streaming calls before any items are sent", func(t *testing.T) {
+		t.Helper()
+
+		srv := newServer(t)
+		client := srv.Client()
+		defer client.Close()
+
+		srv.SetError(statisticofootballdatatest.AllMethods, status.Error(codes.Internal, "database unavailable"))
+
+		teams, err := client.Teams.BySeasonID(context.Background(), 16036)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, 0, len(teams))
+		assert.Equal(t, statisticofootballdata.ErrorClassExternalServer, statisticofootballdata.ErrorClass(err))
+
+		srv.Reset()
+
+		_, err = client.Teams.BySeasonID(context.Background(), 16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+	})
+
+	t.Run("does not fail health checks with errors set for every method", func(t *testing.T) {
+		t.Helper()
+
+		srv := newServer(t, statisticofootballdatatest.WithError(
+			statisticofootballdatatest.AllMethods,
+			status.Error(codes.Unavailable, "database unavailable"),
+		))
+		client := srv.Client()
+		defer client.Close()
+
+		_, err := client.Teams.ByID(context.Background(), 1)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		report, err := client.Health(context.Background())
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, len(statisticofootballdata.Services), len(report))
+	})
+
+	t.Run("delays calls and respects the deadline", func(t *testing.T) {
+		t.Helper()
+
+		srv := newServer(t, statisticofootballdatatest.WithLatency(statisticofootballdatatest.AllMethods, 50*time.Millisecond))
+		client := srv.Client()
+		defer client.Close()
+
+		start := time.Now()
+
+		_, err := client.Teams.ByID(context.Background(), 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err = client.Teams.ByID(ctx, 1)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Contains(t, err.Error(), "DeadlineExceeded")
+	})
+
+	t.Run("calls hooks with the method and request", func(t *testing.T) {
+		t.Helper()
+
+		var methods []string
+
+		srv := newServer(t, statisticofootballdatatest.WithHook(func(_ context.Context, method string, req any) error {
+			methods = append(methods, method)
+
+			if r, ok := req.(*statistico.SeasonTeamsRequest); ok && r.GetSeasonId() == 17420 {
+				return status.Error(codes.Unavailable, "season unavailable")
+			}
+
+			return nil
+		}))
+		client := srv.Client()
+		defer client.Close()
+
+		_, err := client.Teams.ByID(context.Background(), 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		_, err = client.Teams.BySeasonID(context.Background(), 17420)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.Equal(t, statisticofootballdata.ErrorClassExternalServer, statisticofootballdata.ErrorClass(err))
+		assert.Equal(t, []string{
+			statistico.TeamService_GetTeamByID_FullMethodName,
+			statistico.TeamService_GetTeamsBySeasonId_FullMethodName,
+		}, methods)
+	})
+}
+
+func newServer(t *testing.T, opts ...statisticofootballdatatest.Option) *statisticofootballdatatest.Server {
+	ds, err := statisticofootballdatatest.LoadDataset("testdata/dataset.json")
+
+	if err != nil {
+		t.Fatalf("Expected nil, got %s", err.Error())
+	}
+
+	srv := statisticofootballdatatest.NewServer(ds, opts...)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func teamIDs(teams []*statistico.Team) []uint64 {
+	ids := make([]uint64, len(teams))
+
+	for i, t := range teams {
+		ids[i] = t.GetId()
+	}
+
+	return ids
+}
+
+func fixtureIDs(fixtures []*statistico.Fixture) []int64 {
+	ids := make([]int64, len(fixtures))
+
+	for i, f := range fixtures {
+		ids[i] = f.GetId()
+	}
+
+	return ids
+}
+
+func TestDataServiceMethod(t *testing.T) {
+	assert.True(t, statisticofootballdatatest.DataServiceMethod(statistico.TeamService_GetTeamByID_FullMethodName))
+	assert.True(t, statisticofootballdatatest.DataServiceMethod(statistico.FixtureService_Search_FullMethodName))
+	assert.False(t, statisticofootballdatatest.DataServiceMethod("/grpc.health.v1.Health/Check"))
+	assert.False(t, statisticofootballdatatest.DataServiceMethod("/grpc.reflection.v1.ServerReflection/ServerReflectionInfo"))
+}
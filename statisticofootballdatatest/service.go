@@ -0,0 +1,188 @@
+package statisticofootballdatatest
+
+import (
+	"context"
+	statistico "github.com/statistico/statistico-proto/go"
+	"google.golang.org/grpc"
+	"sync/atomic"
+)
+
+// Service implements the data services wrapped by the clients, serving a Dataset that can be replaced
+// while it is in use.
+type Service struct {
+	data atomic.Pointer[Dataset]
+}
+
+// NewService creates a Service serving ds.
+func NewService(ds *Dataset) *Service {
+	s := &Service{}
+	s.SetDataset(ds)
+
+	return s
+}
+
+// Dataset returns the Dataset being served.
+func (s *Service) Dataset() *Dataset {
+	return s.data.Load()
+}
+
+// SetDataset replaces the Dataset being served, affecting calls made from then on.
+func (s *Service) SetDataset(ds *Dataset) {
+	if ds == nil {
+		ds = &Dataset{}
+	}
+
+	s.data.Store(ds)
+}
+
+// Register registers the data services with r, such as a *grpc.Server.
+func (s *Service) Register(r grpc.ServiceRegistrar) {
+	statistico.RegisterCompetitionServiceServer(r, competitionServer{s: s})
+	statistico.RegisterEventServiceServer(r, eventServer{s: s})
+	statistico.RegisterFixtureServiceServer(r, fixtureServer{s: s})
+	statistico.RegisterPlayerServiceServer(r, playerServer{s: s})
+	statistico.RegisterPlayerStatsServiceServer(r, playerStatsServer{s: s})
+	statistico.RegisterSeasonServiceServer(r, seasonServer{s: s})
+	statistico.RegisterTeamServiceServer(r, teamServer{s: s})
+	statistico.RegisterTeamStatsServiceServer(r, teamStatsServer{s: s})
+}
+
+func send[T any](items []*T, stream grpc.ServerStreamingServer[T]) error {
+	for _, item := range items {
+		if err := stream.Send(item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type competitionServer struct {
+	statistico.UnimplementedCompetitionServiceServer
+	s *Service
+}
+
+func (c competitionServer) ListCompetitions(req *statistico.CompetitionRequest, stream grpc.ServerStreamingServer[statistico.Competition]) error {
+	competitions, err := c.s.Dataset().competitions(req.GetCountryIds(), req.GetSort().GetValue())
+
+	if err != nil {
+		return err
+	}
+
+	return send(competitions, stream)
+}
+
+type eventServer struct {
+	statistico.UnimplementedEventServiceServer
+	s *Service
+}
+
+func (e eventServer) FixtureEvents(_ context.Context, req *statistico.FixtureRequest) (*statistico.FixtureEventsResponse, error) {
+	return e.s.Dataset().events(req.GetFixtureId())
+}
+
+type fixtureServer struct {
+	statistico.UnimplementedFixtureServiceServer
+	s *Service
+}
+
+func (f fixtureServer) ListSeasonFixtures(req *statistico.SeasonFixtureRequest, stream grpc.ServerStreamingServer[statistico.Fixture]) error {
+	fixtures, err := f.s.Dataset().seasonFixtures(req)
+
+	if err != nil {
+		return err
+	}
+
+	return send(fixtures, stream)
+}
+
+func (f fixtureServer) FixtureByID(_ context.Context, req *statistico.FixtureRequest) (*statistico.Fixture, error) {
+	return f.s.Dataset().fixture(req.GetFixtureId())
+}
+
+func (f fixtureServer) Search(req *statistico.FixtureSearchRequest, stream grpc.ServerStreamingServer[statistico.Fixture]) error {
+	fixtures, err := f.s.Dataset().search(req)
+
+	if err != nil {
+		return err
+	}
+
+	return send(fixtures, stream)
+}
+
+type playerServer struct {
+	statistico.UnimplementedPlayerServiceServer
+	s *Service
+}
+
+func (p playerServer) GetPlayerByID(_ context.Context, req *statistico.PlayerRequest) (*statistico.Player, error) {
+	return p.s.Dataset().player(req.GetPlayerId())
+}
+
+type playerStatsServer struct {
+	statistico.UnimplementedPlayerStatsServiceServer
+	s *Service
+}
+
+func (p playerStatsServer) GetPlayerStatsForFixture(_ context.Context, req *statistico.FixtureRequest) (*statistico.PlayerStatsResponse, error) {
+	return p.s.Dataset().playerStats(req.GetFixtureId())
+}
+
+func (p playerStatsServer) GetLineUpForFixture(_ context.Context, req *statistico.FixtureRequest) (*statistico.LineupResponse, error) {
+	return p.s.Dataset().lineup(req.GetFixtureId())
+}
+
+func (p playerStatsServer) GetTeamSeasonPlayerStats(req *statistico.TeamSeasonPlayStatsRequest, stream grpc.ServerStreamingServer[statistico.PlayerStats]) error {
+	return send(p.s.Dataset().teamSeasonPlayerStats(req.GetTeamId(), req.GetSeasonId()), stream)
+}
+
+type seasonServer struct {
+	statistico.UnimplementedSeasonServiceServer
+	s *Service
+}
+
+func (ss seasonServer) GetSeasonsForCompetition(req *statistico.SeasonCompetitionRequest, stream grpc.ServerStreamingServer[statistico.Season]) error {
+	seasons, err := ss.s.Dataset().seasonsForCompetition(req.GetCompetitionId(), req.GetSort().GetValue())
+
+	if err != nil {
+		return err
+	}
+
+	return send(seasons, stream)
+}
+
+func (ss seasonServer) GetSeasonsForTeam(_ context.Context, req *statistico.TeamSeasonsRequest) (*statistico.TeamSeasonsResponse, error) {
+	seasons, err := ss.s.Dataset().seasonsForTeam(req.GetTeamId(), req.GetSort().GetValue())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &statistico.TeamSeasonsResponse{Seasons: seasons}, nil
+}
+
+type teamServer struct {
+	statistico.UnimplementedTeamServiceServer
+	s *Service
+}
+
+func (t teamServer) GetTeamByID(_ context.Context, req *statistico.TeamRequest) (*statistico.Team, error) {
+	return t.s.Dataset().team(req.GetTeamId())
+}
+
+func (t teamServer) GetTeamsByCompetitionId(_ context.Context, req *statistico.CompetitionTeamsRequest) (*statistico.TeamsResponse, error) {
+	return &statistico.TeamsResponse{Teams: t.s.Dataset().teamsByCompetitions(req.GetCompetitionIds())}, nil
+}
+
+func (t teamServer) GetTeamsBySeasonId(req *statistico.SeasonTeamsRequest, stream grpc.ServerStreamingServer[statistico.Team]) error {
+	return send(t.s.Dataset().teamsBySeason(req.GetSeasonId()), stream)
+}
+
+type teamStatsServer struct {
+	statistico.UnimplementedTeamStatsServiceServer
+	s *Service
+}
+
+func (t teamStatsServer) GetTeamStatsForFixture(_ context.Context, req *statistico.FixtureRequest) (*statistico.TeamStatsResponse, error) {
+	return t.s.Dataset().teamStats(req.GetFixtureId())
+}
@@ -15,66 +15,73 @@ type TeamClient interface {
 
 type teamClient struct {
 	client statistico.TeamServiceClient
+	opts   options
 }
 
 func (t *teamClient) ByID(ctx context.Context, teamID uint64) (*statistico.Team, error) {
 	req := statistico.TeamRequest{TeamId: teamID}
 
-	team, err := t.client.GetTeamByID(ctx, &req)
+	return invokeUnary(ctx, t.opts, MethodTeamByID, &req, func(ctx context.Context) (*statistico.Team, error) {
+		team, err := t.client.GetTeamByID(ctx, &req)
 
-	if err != nil {
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.NotFound:
-				return nil, ErrorNotFound{ID: teamID, err: err}
-			default:
-				return nil, ErrorBadGateway{err}
+		if err != nil {
+			if e, ok := status.FromError(err); ok {
+				switch e.Code() {
+				case codes.NotFound:
+					return nil, ErrorNotFound{ID: teamID, err: err}
+				default:
+					return nil, ErrorBadGateway{err}
+				}
 			}
-		}
 
-		return nil, err
-	}
+			return nil, err
+		}
 
-	return team, nil
+		return team, nil
+	})
 }
 
 func (t *teamClient) BySeasonID(ctx context.Context, seasonId uint64) ([]*statistico.Team, error) {
-	teams := []*statistico.Team{}
-
 	req := statistico.SeasonTeamsRequest{SeasonId: seasonId}
 
-	stream, err := t.client.GetTeamsBySeasonId(ctx, &req)
+	return invokeStream(ctx, t.opts, MethodTeamBySeasonID, &req, func(ctx context.Context, call *Call) ([]*statistico.Team, error) {
+		teams := []*statistico.Team{}
 
-	if err != nil {
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.Internal:
-				return teams, ErrorExternalServer{err}
-			default:
-				return teams, ErrorBadGateway{err}
+		stream, err := t.client.GetTeamsBySeasonId(ctx, &req)
+
+		if err != nil {
+			if e, ok := status.FromError(err); ok {
+				switch e.Code() {
+				case codes.Internal:
+					return teams, ErrorExternalServer{err}
+				default:
+					return teams, ErrorBadGateway{err}
+				}
 			}
+
+			return nil, err
 		}
 
-		return nil, err
-	}
+		for {
+			team, err := stream.Recv()
 
-	for {
-		team, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
 
-		if err == io.EOF {
-			break
-		}
+			if err != nil {
+				return teams, ErrorExternalServer{err}
+			}
 
-		if err != nil {
-			return teams, ErrorExternalServer{err}
-		}
+			call.received(team)
 
-		teams = append(teams, team)
-	}
+			teams = append(teams, team)
+		}
 
-	return teams, nil
+		return teams, nil
+	})
 }
 
-func NewTeamClient(p statistico.TeamServiceClient) TeamClient {
-	return &teamClient{client: p}
+func NewTeamClient(p statistico.TeamServiceClient, opts ...Option) TeamClient {
+	return &teamClient{client: p, opts: newOptions(opts)}
 }
@@ -13,29 +13,32 @@ type TeamStatClient interface {
 
 type teamStatClient struct {
 	client statistico.TeamStatsServiceClient
+	opts   options
 }
 
 func (t *teamStatClient) Stats(ctx context.Context, req *statistico.FixtureRequest) (*statistico.TeamStatsResponse, error) {
-	res, err := t.client.GetTeamStatsForFixture(ctx, req)
-
-	if err != nil {
-		if e, ok := status.FromError(err); ok {
-			switch e.Code() {
-			case codes.InvalidArgument:
-				return nil, ErrorInvalidArgument{err}
-			case codes.Internal:
-				return nil, ErrorExternalServer{err}
-			default:
-				return nil, ErrorBadGateway{err}
+	return invokeUnary(ctx, t.opts, MethodTeamStatStats, req, func(ctx context.Context) (*statistico.TeamStatsResponse, error) {
+		res, err := t.client.GetTeamStatsForFixture(ctx, req)
+
+		if err != nil {
+			if e, ok := status.FromError(err); ok {
+				switch e.Code() {
+				case codes.InvalidArgument:
+					return nil, ErrorInvalidArgument{err}
+				case codes.Internal:
+					return nil, ErrorExternalServer{err}
+				default:
+					return nil, ErrorBadGateway{err}
+				}
 			}
-		}
 
-		return nil, err
-	}
+			return nil, err
+		}
 
-	return res, nil
+		return res, nil
+	})
 }
 
-func NewTeamStatClient(p statistico.TeamStatsServiceClient) TeamStatClient {
-	return &teamStatClient{client: p}
+func NewTeamStatClient(p statistico.TeamStatsServiceClient, opts ...Option) TeamStatClient {
+	return &teamStatClient{client: p, opts: newOptions(opts)}
 }
@@ -0,0 +1,174 @@
+// Package tracing provides OpenTelemetry instrumentation for the data service clients, creating a
+// span for every client method call and propagating the trace context to the data service.
+package tracing
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"sync/atomic"
+)
+
+const instrumentationName = "github.com/statistico/statistico-football-data-go-grpc-client/tracing"
+
+// Attribute keys set on client spans in addition to rpc.system and rpc.method. The ID keys are named
+// after the fields returned by statisticofootballdata.RequestFields.
+const (
+	CompetitionIDKey = attribute.Key("statistico.competition_id")
+	CountryIDsKey    = attribute.Key("statistico.country_ids")
+	ErrorTypeKey     = attribute.Key("statistico.error.type")
+	FixtureIDKey     = attribute.Key("statistico.fixture_id")
+	ItemsKey         = attribute.Key("statistico.items")
+	PlayerIDKey      = attribute.Key("statistico.player_id")
+	SeasonIDKey      = attribute.Key("statistico.season_id")
+	SeasonIDsKey     = attribute.Key("statistico.season_ids")
+	TeamIDKey        = attribute.Key("statistico.team_id")
+)
+
+// Option configures the tracing middleware.
+type Option func(c *config)
+
+type config struct {
+	provider   trace.TracerProvider
+	propagator propagation.TextMapPropagator
+}
+
+// WithTracerProvider sets the provider used to create tracers, defaulting to the global provider.
+func WithTracerProvider(p trace.TracerProvider) Option {
+	return func(c *config) {
+		c.provider = p
+	}
+}
+
+// WithPropagator sets the propagator used to inject the trace context into outgoing gRPC metadata,
+// defaulting to the global propagator.
+func WithPropagator(p propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagator = p
+	}
+}
+
+// Middleware returns client middleware creating a span named after the client method for every call,
+// e.g. TeamClient.ByID. Spans record the IDs in the request, the number of items received by streaming
+// methods and the class of any error returned.
+func Middleware(opts ...Option) statisticofootballdata.Middleware {
+	c := config{
+		provider:   otel.GetTracerProvider(),
+		propagator: otel.GetTextMapPropagator(),
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	tracer := c.provider.Tracer(instrumentationName)
+
+	return func(next statisticofootballdata.Invoker) statisticofootballdata.Invoker {
+		return func(ctx context.Context, call *statisticofootballdata.Call) (any, error) {
+			attrs := append([]attribute.KeyValue{
+				attribute.String("rpc.system", "grpc"),
+				attribute.String("rpc.method", call.Method),
+			}, requestAttributes(call.Request)...)
+
+			ctx, span := tracer.Start(ctx, call.Method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+			defer span.End()
+
+			var items atomic.Int64
+
+			if call.Streaming {
+				call.OnRecv(func(proto.Message) {
+					items.Add(1)
+				})
+			}
+
+			res, err := next(inject(ctx, c.propagator), call)
+
+			if call.Streaming {
+				span.SetAttributes(ItemsKey.Int64(items.Load()))
+			}
+
+			if err != nil {
+				span.SetAttributes(ErrorTypeKey.String(statisticofootballdata.ErrorClass(err)))
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+
+			return res, err
+		}
+	}
+}
+
+// inject adds the trace context held by ctx to its outgoing gRPC metadata.
+func inject(ctx context.Context, p propagation.TextMapPropagator) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+
+	p.Inject(ctx, metadataCarrier(md))
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+type metadataCarrier metadata.MD
+
+func (m metadataCarrier) Get(key string) string {
+	values := metadata.MD(m).Get(key)
+
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+func (m metadataCarrier) Set(key, value string) {
+	metadata.MD(m).Set(key, value)
+}
+
+func (m metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// requestAttributes returns attributes identifying the resources a request is for.
+func requestAttributes(req proto.Message) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+
+	for _, f := range statisticofootballdata.RequestFields(req) {
+		key := attribute.Key("statistico." + f.Name)
+
+		if f.Repeated {
+			attrs = append(attrs, key.Int64Slice(toInt64s(f.IDs)))
+			continue
+		}
+
+		attrs = append(attrs, key.Int64(int64(f.IDs[0])))
+	}
+
+	return attrs
+}
+
+func toInt64s(ids []uint64) []int64 {
+	res := make([]int64, len(ids))
+
+	for i, id := range ids {
+		res[i] = int64(id)
+	}
+
+	return res
+}
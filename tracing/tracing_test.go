@@ -0,0 +1,147 @@
+package tracing_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-football-data-go-grpc-client/statisticofootballdatatest"
+	"github.com/statistico/statistico-football-data-go-grpc-client/tracing"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	t.Run("creates a span per call with request attributes and propagates trace context", func(t *testing.T) {
+		t.Helper()
+
+		recorder, opts := newRecorder()
+
+		var md metadata.MD
+
+		client := newTeamClient(t, tracing.Middleware(opts...), statisticofootballdatatest.WithHook(func(ctx context.Context, _ string, _ any) error {
+			md, _ = metadata.FromIncomingContext(ctx)
+			return nil
+		}))
+
+		_, err := client.ByID(context.Background(), 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		spans := recorder.Ended()
+
+		assert.Equal(t, 1, len(spans))
+		assert.Equal(t, "TeamClient.ByID", spans[0].Name())
+		assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+		assert.Contains(t, spans[0].Attributes(), tracing.TeamIDKey.Int64(1))
+		assert.Contains(t, spans[0].Attributes(), attribute.String("rpc.method", "TeamClient.ByID"))
+		assert.Equal(t, otelcodes.Unset, spans[0].Status().Code)
+
+		assert.Equal(t, 1, len(md.Get("traceparent")))
+		assert.Contains(t, md.Get("traceparent")[0], spans[0].SpanContext().TraceID().String())
+	})
+
+	t.Run("records the number of items received by streaming methods", func(t *testing.T) {
+		t.Helper()
+
+		recorder, opts := newRecorder()
+
+		client := newTeamClient(t, tracing.Middleware(opts...))
+
+		teams, err := client.BySeasonID(context.Background(), 16036)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		spans := recorder.Ended()
+
+		assert.Equal(t, 3, len(teams))
+		assert.Equal(t, "TeamClient.BySeasonID", spans[0].Name())
+		assert.Contains(t, spans[0].Attributes(), tracing.SeasonIDKey.Int64(16036))
+		assert.Contains(t, spans[0].Attributes(), tracing.ItemsKey.Int64(3))
+	})
+
+	t.Run("records the class of errors returned", func(t *testing.T) {
+		t.Helper()
+
+		recorder, opts := newRecorder()
+
+		client := newTeamClient(t, tracing.Middleware(opts...), statisticofootballdatatest.WithError(
+			statistico.TeamService_GetTeamByID_FullMethodName,
+			status.Error(codes.NotFound, "not found"),
+		))
+
+		_, err := client.ByID(context.Background(), 5)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		spans := recorder.Ended()
+
+		assert.Contains(t, spans[0].Attributes(), tracing.ErrorTypeKey.String("NotFound"))
+		assert.Equal(t, otelcodes.Error, spans[0].Status().Code)
+		assert.Equal(t, "resource with ID '5' does not exist. Error: rpc error: code = NotFound desc = not found", spans[0].Status().Description)
+	})
+
+	t.Run("preserves existing outgoing metadata", func(t *testing.T) {
+		t.Helper()
+
+		_, opts := newRecorder()
+
+		var md metadata.MD
+
+		client := newTeamClient(t, tracing.Middleware(opts...), statisticofootballdatatest.WithHook(func(ctx context.Context, _ string, _ any) error {
+			md, _ = metadata.FromIncomingContext(ctx)
+			return nil
+		}))
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "token")
+
+		_, err := client.ByID(ctx, 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		original, _ := metadata.FromOutgoingContext(ctx)
+
+		assert.Equal(t, []string{"token"}, md.Get("authorization"))
+		assert.Equal(t, 1, len(md.Get("traceparent")))
+		assert.Equal(t, 0, len(original.Get("traceparent")))
+	})
+}
+
+func newRecorder() (*tracetest.SpanRecorder, []tracing.Option) {
+	recorder := tracetest.NewSpanRecorder()
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	return recorder, []tracing.Option{
+		tracing.WithTracerProvider(provider),
+		tracing.WithPropagator(propagation.TraceContext{}),
+	}
+}
+
+// newTeamClient returns a TeamClient using mw over a statisticofootballdatatest.Server serving three
+// teams in season 16036.
+func newTeamClient(t *testing.T, mw statisticofootballdata.Middleware, opts ...statisticofootballdatatest.Option) statisticofootballdata.TeamClient {
+	srv := statisticofootballdatatest.NewServer(&statisticofootballdatatest.Dataset{
+		Teams:       []*statistico.Team{{Id: 1}, {Id: 2}, {Id: 3}},
+		SeasonTeams: map[uint64][]uint64{16036: {1, 2, 3}},
+	}, opts...)
+	t.Cleanup(srv.Close)
+
+	return statisticofootballdata.NewTeamClient(statistico.NewTeamServiceClient(srv.Conn()), statisticofootballdata.WithMiddleware(mw))
+}
@@ -0,0 +1,106 @@
+package statisticofootballdata
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/keepalive"
+	"time"
+)
+
+// Compressors for use with WithCompressor and WithCallCompressor.
+const (
+	Gzip = "gzip"
+	None = ""
+)
+
+// DefaultMaxReceiveMessageSize is the gRPC default of 4MB. Responses describe a single fixture, team or
+// player, and searches stream a message per result, so messages are far smaller than this.
+const DefaultMaxReceiveMessageSize = 4 * 1024 * 1024
+
+// DefaultKeepalive pings connections every five minutes, including idle connections, to stop NAT gateways
+// and load balancers dropping them silently. Five minutes is the minimum ping interval enforced by gRPC
+// servers by default, but servers close connections pinged without calls in progress unless their
+// keepalive enforcement policy sets PermitWithoutStream. Against such servers use WithKeepalive with
+// PermitWithoutStream unset, which only pings connections while calls are in progress.
+var DefaultKeepalive = keepalive.ClientParameters{
+	Time:                5 * time.Minute,
+	Timeout:             20 * time.Second,
+	PermitWithoutStream: true,
+}
+
+// WithCompressor sets the compressor used for every call, such as Gzip. Requests are small so are not
+// compressed by default, but gzip compressed responses are always accepted, letting the data service
+// compress large Search results and stats responses. Use WithCallCompressor to set the compressor of a
+// single call.
+func WithCompressor(name string) DialOption {
+	return func(o *dialOptions) {
+		o.compressor = name
+	}
+}
+
+type compressorKey struct{}
+
+// WithCallCompressor returns a context carrying the compressor used for calls made with the context, such
+// as Gzip to compress a single large request, or None to send a call uncompressed when WithCompressor is
+// set. It applies to calls over connections created by Dial, DialFailover and NewPool.
+func WithCallCompressor(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, compressorKey{}, name)
+}
+
+// callCompressor adds the compressor carried by the context of a call to its call options.
+func callCompressor(ctx context.Context, opts []grpc.CallOption) []grpc.CallOption {
+	if name, ok := ctx.Value(compressorKey{}).(string); ok {
+		return append(opts, grpc.UseCompressor(name))
+	}
+
+	return opts
+}
+
+func compressUnary(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(ctx, method, req, reply, cc, callCompressor(ctx, opts)...)
+}
+
+func compressStream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(ctx, desc, cc, method, callCompressor(ctx, opts)...)
+}
+
+// WithMaxReceiveMessageSize sets the size in bytes of the largest message that can be received,
+// defaulting to DefaultMaxReceiveMessageSize.
+func WithMaxReceiveMessageSize(n int) DialOption {
+	return func(o *dialOptions) {
+		o.maxReceiveMessageSize = n
+	}
+}
+
+// WithKeepalive sets the keepalive parameters of connections, defaulting to DefaultKeepalive.
+func WithKeepalive(p keepalive.ClientParameters) DialOption {
+	return func(o *dialOptions) {
+		o.keepalive = p
+	}
+}
+
+// grpcDialOptions returns the options used to create gRPC connections, with options provided using
+// WithGRPCDialOptions taking precedence.
+func (o dialOptions) grpcDialOptions() []grpc.DialOption {
+	callOpts := []grpc.CallOption{grpc.MaxCallRecvMsgSize(o.maxReceiveMessageSize)}
+
+	if o.compressor != None {
+		callOpts = append(callOpts, grpc.UseCompressor(o.compressor))
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithKeepaliveParams(o.keepalive),
+		grpc.WithDefaultCallOptions(callOpts...),
+		grpc.WithChainUnaryInterceptor(compressUnary),
+		grpc.WithChainStreamInterceptor(compressStream),
+	}
+
+	if cfg := o.defaultServiceConfig(); cfg != "" {
+		opts = append(opts, grpc.WithDefaultServiceConfig(cfg))
+	}
+
+	return append(opts, o.grpc...)
+}
@@ -0,0 +1,242 @@
+package statisticofootballdata_test
+
+import (
+	"context"
+	"github.com/statistico/statistico-football-data-go-grpc-client"
+	"github.com/statistico/statistico-proto/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestWithCompressor(t *testing.T) {
+	t.Run("compresses calls using gzip", func(t *testing.T) {
+		t.Helper()
+
+		srv := startEncodingServer(t, "West Ham United")
+		defer srv.stop()
+
+		client, err := statisticofootballdata.Dial(srv.addr, statisticofootballdata.WithCompressor(statisticofootballdata.Gzip))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		team, err := client.Teams.ByID(context.Background(), 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "West Ham United", team.GetName())
+		assert.Equal(t, []string{"gzip"}, srv.encodings())
+	})
+
+	t.Run("does not compress calls by default but accepts gzip responses", func(t *testing.T) {
+		t.Helper()
+
+		srv := startEncodingServer(t, "West Ham United")
+		defer srv.stop()
+
+		client, err := statisticofootballdata.Dial(srv.addr)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		_, err = client.Teams.ByID(context.Background(), 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []string{""}, srv.encodings())
+		assert.Contains(t, srv.accepted(), "gzip")
+	})
+}
+
+func TestWithCallCompressor(t *testing.T) {
+	t.Run("compresses single calls over a connection", func(t *testing.T) {
+		t.Helper()
+
+		srv := startEncodingServer(t, "West Ham United")
+		defer srv.stop()
+
+		client, err := statisticofootballdata.Dial(srv.addr)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		gzip := statisticofootballdata.WithCallCompressor(context.Background(), statisticofootballdata.Gzip)
+
+		if _, err := client.Teams.ByID(gzip, 1); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		if _, err := client.Teams.ByID(context.Background(), 1); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		if _, err := client.Teams.BySeasonID(gzip, 16036); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []string{"gzip", "", "gzip"}, srv.encodings())
+	})
+
+	t.Run("sends single calls uncompressed when a compressor is set for every call", func(t *testing.T) {
+		t.Helper()
+
+		srv := startEncodingServer(t, "West Ham United")
+		defer srv.stop()
+
+		client, err := statisticofootballdata.Dial(srv.addr, statisticofootballdata.WithCompressor(statisticofootballdata.Gzip))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		if _, err := client.Teams.ByID(statisticofootballdata.WithCallCompressor(context.Background(), statisticofootballdata.None), 1); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		if _, err := client.Teams.ByID(context.Background(), 1); err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []string{"", "gzip"}, srv.encodings())
+	})
+}
+
+func TestWithMaxReceiveMessageSize(t *testing.T) {
+	t.Run("receives messages larger than the gRPC default if configured", func(t *testing.T) {
+		t.Helper()
+
+		srv := startEncodingServer(t, strings.Repeat("a", 5*1024*1024))
+		defer srv.stop()
+
+		client, err := statisticofootballdata.Dial(srv.addr, statisticofootballdata.WithMaxReceiveMessageSize(8*1024*1024))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		team, err := client.Teams.ByID(context.Background(), 1)
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 5*1024*1024, len(team.GetName()))
+	})
+
+	t.Run("returns bad gateway error for messages larger than the configured size", func(t *testing.T) {
+		t.Helper()
+
+		srv := startEncodingServer(t, strings.Repeat("a", 2*1024))
+		defer srv.stop()
+
+		client, err := statisticofootballdata.Dial(srv.addr, statisticofootballdata.WithMaxReceiveMessageSize(1024))
+
+		if err != nil {
+			t.Fatalf("Expected nil, got %s", err.Error())
+		}
+
+		defer client.Close()
+
+		_, err = client.Teams.ByID(context.Background(), 1)
+
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		assert.IsType(t, statisticofootballdata.ErrorBadGateway{}, err)
+		assert.Contains(t, err.Error(), "ResourceExhausted")
+	})
+}
+
+type encodingServer struct {
+	*teamServer
+
+	mu        sync.Mutex
+	encoding  []string
+	acceptsIn []string
+}
+
+func (s *encodingServer) intercept(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	s.mu.Lock()
+	s.acceptsIn = append(s.acceptsIn, md.Get("grpc-accept-encoding")...)
+	s.mu.Unlock()
+
+	return handler(ctx, req)
+}
+
+func (s *encodingServer) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (s *encodingServer) HandleRPC(_ context.Context, rs stats.RPCStats) {
+	if h, ok := rs.(*stats.InHeader); ok {
+		s.mu.Lock()
+		s.encoding = append(s.encoding, h.Compression)
+		s.mu.Unlock()
+	}
+}
+
+func (s *encodingServer) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (s *encodingServer) HandleConn(context.Context, stats.ConnStats) {}
+
+func (s *encodingServer) encodings() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.encoding
+}
+
+func (s *encodingServer) accepted() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return strings.Join(s.acceptsIn, ",")
+}
+
+func startEncodingServer(t *testing.T, name string) *encodingServer {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("Expected nil, got %s", err.Error())
+	}
+
+	s := &encodingServer{teamServer: &teamServer{name: name, addr: lis.Addr().String()}}
+
+	srv := grpc.NewServer(grpc.UnaryInterceptor(s.intercept), grpc.StatsHandler(s))
+
+	statistico.RegisterTeamServiceServer(srv, s.teamServer)
+
+	go srv.Serve(lis)
+
+	s.teamServer.stop = srv.Stop
+
+	return s
+}